@@ -0,0 +1,203 @@
+package cycles
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/cmd/show"
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/internal/graphflags"
+	"github.com/LegacyCodeHQ/clarity/internal/repofiles"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/spf13/cobra"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+type cyclesOptions struct {
+	outputFormat string
+	repoPath     string
+	allowOutside bool
+	includes     []string
+	buildOptions depgraph.BuildOptions
+}
+
+type cycleReport struct {
+	Files  []string           `json:"files"`
+	Path   []string           `json:"path"`
+	Breaks []cycleBreakReport `json:"breaks"`
+}
+
+type cycleBreakReport struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Cycles int    `json:"cycles"`
+}
+
+// Cmd represents the cycles command.
+var Cmd = NewCommand()
+
+// NewCommand returns a new cycles command instance.
+func NewCommand() *cobra.Command {
+	opts := &cyclesOptions{
+		outputFormat: formatText,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cycles",
+		Short: "List dependency cycles and the edges to remove to break them",
+		Long: `List each group of files that depend on each other in a cycle, together with a minimal
+set of dependencies whose removal breaks every cycle. Suggestions are ranked by the number
+of cycles each dependency takes part in.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCycles(cmd, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&opts.outputFormat,
+		"format",
+		"f",
+		opts.outputFormat,
+		fmt.Sprintf("Output format (%s)", supportedFormats()))
+	cmd.Flags().StringVarP(&opts.repoPath, "repo", "r", "", "Git repository path (default: current directory)")
+	cmd.Flags().BoolVar(&opts.allowOutside, "allow-outside-repo", false, "Allow input paths outside the repo root")
+	cmd.Flags().StringSliceVarP(&opts.includes, "input", "i", nil, "Analyze specific files and/or directories (comma-separated, default: whole repository)")
+	graphflags.Register(cmd, &opts.buildOptions)
+
+	return cmd
+}
+
+func runCycles(cmd *cobra.Command, opts *cyclesOptions) error {
+	if !isSupportedFormat(opts.outputFormat) {
+		return fmt.Errorf("unknown format: %s (valid options: %s)", opts.outputFormat, supportedFormats())
+	}
+
+	pathResolver, err := show.NewPathResolver(opts.repoPath, opts.allowOutside)
+	if err != nil {
+		return fmt.Errorf("failed to create path resolver: %w", err)
+	}
+	repoPath := pathResolver.BaseDir()
+	graphflags.ResolveRoots(&opts.buildOptions, repoPath)
+
+	roots := []string{repoPath}
+	if len(opts.includes) > 0 {
+		roots = roots[:0]
+		for _, include := range opts.includes {
+			resolved, err := pathResolver.Resolve(show.RawPath(include))
+			if err != nil {
+				return fmt.Errorf("failed to resolve input path %q: %w", include, err)
+			}
+			roots = append(roots, resolved.String())
+		}
+	}
+
+	filePaths, err := repofiles.CollectSupported(roots...)
+	if err != nil {
+		return fmt.Errorf("failed to collect files: %w", err)
+	}
+	if len(filePaths) == 0 {
+		return fmt.Errorf("no supported files found")
+	}
+
+	contentReader := vcs.FilesystemContentReader()
+	built, err := depgraph.BuildDependencyGraphWithOptions(filePaths, contentReader, opts.buildOptions)
+	graph := built.Graph
+	if err != nil {
+		return fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+	adjacency, err := depgraph.AdjacencyList(graph)
+	if err != nil {
+		return fmt.Errorf("failed to read dependency graph: %w", err)
+	}
+
+	reports := buildCycleReports(repoPath, adjacency, depgraph.FindCycles(adjacency))
+
+	switch strings.ToLower(opts.outputFormat) {
+	case formatJSON:
+		output, err := json.MarshalIndent(struct {
+			Cycles []cycleReport `json:"cycles"`
+		}{Cycles: reports}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode cycles: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(output))
+	default:
+		fmt.Fprintln(cmd.OutOrStdout(), formatTextOutput(reports))
+	}
+	return nil
+}
+
+func buildCycleReports(repoRoot string, adjacency map[string][]string, cycles []depgraph.FileCycle) []cycleReport {
+	reports := make([]cycleReport, 0, len(cycles))
+	for _, cycle := range cycles {
+		breaks := depgraph.CycleBreaks(adjacency, cycle)
+		report := cycleReport{
+			Files:  repofiles.DisplayPaths(repoRoot, cycle.Nodes),
+			Path:   repofiles.DisplayPaths(repoRoot, cycle.Path),
+			Breaks: make([]cycleBreakReport, 0, len(breaks)),
+		}
+		for _, cycleBreak := range breaks {
+			report.Breaks = append(report.Breaks, cycleBreakReport{
+				From:   repofiles.DisplayPath(repoRoot, cycleBreak.Edge.From),
+				To:     repofiles.DisplayPath(repoRoot, cycleBreak.Edge.To),
+				Cycles: cycleBreak.Cycles,
+			})
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+func formatTextOutput(reports []cycleReport) string {
+	if len(reports) == 0 {
+		return "No dependency cycles found."
+	}
+
+	var lines []string
+	if len(reports) == 1 {
+		lines = append(lines, "1 dependency cycle found.")
+	} else {
+		lines = append(lines, fmt.Sprintf("%d dependency cycles found.", len(reports)))
+	}
+
+	for i, report := range reports {
+		lines = append(lines, "", fmt.Sprintf("C%d: %s", i+1, fileCountLabel(len(report.Files))))
+		if len(report.Path) > 0 {
+			lines = append(lines, "  "+strings.Join(append(append([]string(nil), report.Path...), report.Path[0]), " -> "))
+		}
+		if len(report.Files) > len(report.Path) {
+			lines = append(lines, "  files: "+strings.Join(report.Files, ", "))
+		}
+		lines = append(lines, "  Suggested breaks:")
+		for _, cycleBreak := range report.Breaks {
+			lines = append(lines, fmt.Sprintf("    %s -> %s (%s)", cycleBreak.From, cycleBreak.To, depgraph.CycleCountLabel(cycleBreak.Cycles)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func fileCountLabel(count int) string {
+	if count == 1 {
+		return "1 file"
+	}
+	return fmt.Sprintf("%d files", count)
+}
+
+func isSupportedFormat(format string) bool {
+	switch strings.ToLower(format) {
+	case formatText, formatJSON:
+		return true
+	default:
+		return false
+	}
+}
+
+func supportedFormats() string {
+	return strings.Join([]string{formatText, formatJSON}, ", ")
+}
@@ -0,0 +1,120 @@
+package cycles
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+}
+
+func TestCyclesCommand_TextSuggestsSharedEdge(t *testing.T) {
+	repoDir := t.TempDir()
+	writeFiles(t, repoDir, map[string]string{
+		"hub.js":  "import { core } from './core.js'\nexport const hub = core\n",
+		"core.js": "import { a } from './a.js'\nimport { b } from './b.js'\nexport const core = a + b\n",
+		"a.js":    "import { hub } from './hub.js'\nexport const a = hub\n",
+		"b.js":    "import { hub } from './hub.js'\nexport const b = hub\n",
+		"util.js": "export const util = 1\n",
+	})
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir})
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"1 dependency cycle found.",
+		"",
+		"C1: 4 files",
+		"  a.js -> hub.js -> core.js -> a.js",
+		"  files: a.js, b.js, core.js, hub.js",
+		"  Suggested breaks:",
+		"    hub.js -> core.js (2 cycles)",
+		"",
+	}, "\n")
+	if got := stdout.String(); got != want {
+		t.Fatalf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestCyclesCommand_NoCycles(t *testing.T) {
+	repoDir := t.TempDir()
+	writeFiles(t, repoDir, map[string]string{
+		"from.js": "import { x } from './to.js'\nexport const y = x\n",
+		"to.js":   "export const x = 1\n",
+	})
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir})
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	if got := stdout.String(); got != "No dependency cycles found.\n" {
+		t.Fatalf("unexpected output:\n%s", got)
+	}
+}
+
+func TestCyclesCommand_JSONFormat(t *testing.T) {
+	repoDir := t.TempDir()
+	writeFiles(t, repoDir, map[string]string{
+		"a.js": "import { b } from './b.js'\nexport const a = b\n",
+		"b.js": "import { a } from './a.js'\nexport const b = a\n",
+	})
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-f", "json"})
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	var payload struct {
+		Cycles []cycleReport `json:"cycles"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v\n%s", err, stdout.String())
+	}
+	if len(payload.Cycles) != 1 {
+		t.Fatalf("expected one cycle, got %+v", payload.Cycles)
+	}
+	cycle := payload.Cycles[0]
+	if strings.Join(cycle.Files, ",") != "a.js,b.js" {
+		t.Fatalf("unexpected files: %v", cycle.Files)
+	}
+	if len(cycle.Breaks) != 1 || cycle.Breaks[0].Cycles != 1 {
+		t.Fatalf("unexpected breaks: %+v", cycle.Breaks)
+	}
+}
+
+func TestCyclesCommand_UnknownFormat(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", t.TempDir(), "-f", "xml"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown format: xml (valid options: text, json)") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
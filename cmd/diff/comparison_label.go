@@ -0,0 +1,83 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// shortCommitLength matches git's default abbreviation.
+const shortCommitLength = 7
+
+// snapshotLabel names one side of a comparison the way it was asked for, such as "main",
+// "stash@{0}" or "index", together with the commit it resolved to, if any.
+type snapshotLabel struct {
+	name   string
+	commit string
+}
+
+// comparisonLabel describes what a diff compared. Every output format shows it.
+type comparisonLabel struct {
+	base   snapshotLabel
+	target snapshotLabel
+}
+
+func (l snapshotLabel) String() string {
+	if l.commit == "" || l.givenAsHash() {
+		return l.shortName()
+	}
+	return fmt.Sprintf("%s (%s)", l.name, l.shortCommit())
+}
+
+// shortName returns the name, abbreviating it when it is a commit hash.
+func (l snapshotLabel) shortName() string {
+	if l.givenAsHash() {
+		return l.shortCommit()
+	}
+	return l.name
+}
+
+// givenAsHash reports whether the ref was written as a commit hash, which needs no second hash next to it.
+func (l snapshotLabel) givenAsHash() bool {
+	if l.commit == "" {
+		return false
+	}
+	// git abbreviates hashes to no fewer than 4 characters; shorter names are branches or tags.
+	return l.name == "" || (len(l.name) >= 4 && strings.HasPrefix(l.commit, strings.ToLower(l.name)) && isHex(l.name))
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l snapshotLabel) shortCommit() string {
+	if len(l.commit) > shortCommitLength {
+		return l.commit[:shortCommitLength]
+	}
+	return l.commit
+}
+
+// String returns "main (a1b2c3d) → feature (d4e5f6a)", or "" when nothing was labelled.
+func (l comparisonLabel) String() string {
+	if l == (comparisonLabel{}) {
+		return ""
+	}
+	return fmt.Sprintf("%s → %s", l.base, l.target)
+}
+
+// sideTitle titles one side of a side-by-side or overlay rendering, such as "base: main (a1b2c3d)".
+func sideTitle(side string, label snapshotLabel) string {
+	if label == (snapshotLabel{}) {
+		return side
+	}
+	return fmt.Sprintf("%s: %s", side, label)
+}
+
+// newComparisonLabel reads the labels of both snapshots.
+func newComparisonLabel(snapshots snapshotPair) comparisonLabel {
+	return comparisonLabel{base: snapshots.base.label, target: snapshots.target.label}
+}
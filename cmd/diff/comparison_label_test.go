@@ -0,0 +1,53 @@
+package diff
+
+import "testing"
+
+func TestComparisonLabel_String(t *testing.T) {
+	const (
+		baseCommit   = "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"
+		targetCommit = "d4e5f6a7b8c90112233445566778899aabbccdde"
+	)
+
+	tests := []struct {
+		name  string
+		label comparisonLabel
+		want  string
+	}{
+		{
+			name: "named refs",
+			label: comparisonLabel{
+				base:   snapshotLabel{name: "main", commit: baseCommit},
+				target: snapshotLabel{name: "stash@{0}", commit: targetCommit},
+			},
+			want: "main (a1b2c3d) → stash@{0} (d4e5f6a)",
+		},
+		{
+			name: "refs given as hashes",
+			label: comparisonLabel{
+				base:   snapshotLabel{name: baseCommit[:10], commit: baseCommit},
+				target: snapshotLabel{name: targetCommit, commit: targetCommit},
+			},
+			want: "a1b2c3d → d4e5f6a",
+		},
+		{
+			name: "hex branch name shorter than an abbreviation",
+			label: comparisonLabel{
+				base:   snapshotLabel{name: "a1b", commit: baseCommit},
+				target: snapshotLabel{name: "working tree"},
+			},
+			want: "a1b (a1b2c3d) → working tree",
+		},
+		{
+			name:  "unlabelled",
+			label: comparisonLabel{},
+			want:  "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.label.String(); got != tc.want {
+				t.Fatalf("String() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package diff
+
+import (
+	"sort"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// cycleChangeKind says how an import cycle differs between the snapshots.
+type cycleChangeKind string
+
+const (
+	// cycleAdded is a target cycle that shares no file with any base cycle.
+	cycleAdded cycleChangeKind = "added"
+	// cycleRemoved is a base cycle that shares no file with any target cycle.
+	cycleRemoved cycleChangeKind = "removed"
+	// cycleChanged is a target cycle that overlaps base cycles but spans a different set of files.
+	cycleChanged cycleChangeKind = "changed"
+)
+
+// cycleChange describes one cycle that was introduced, resolved or reshaped. Cycles are identified
+// by the files of their strongly connected component, so a cycle that gains or loses a file is
+// reported as changed rather than as one cycle removed and another added.
+type cycleChange struct {
+	kind cycleChangeKind
+	// path is a representative cycle through the component, taken from the target snapshot
+	// unless the cycle was removed.
+	path []string
+	// baseNodes and targetNodes list the files of the cycle on each side, sorted. Added cycles
+	// have no base nodes and removed cycles no target nodes.
+	baseNodes   []string
+	targetNodes []string
+}
+
+// joinedNodes returns the target files of a changed cycle that were not part of it in the base.
+func (c cycleChange) joinedNodes() []string {
+	var joined []string
+	for _, n := range c.targetNodes {
+		if !containsString(c.baseNodes, n) {
+			joined = append(joined, n)
+		}
+	}
+	return joined
+}
+
+// diffCycles pairs the cycles of both snapshots by the files they share. Base cycles are expected
+// under their renamed paths so moved files do not count as cycle changes.
+func diffCycles(baseCycles, targetCycles []depgraph.FileCycle) []cycleChange {
+	var changes []cycleChange
+	matchedBase := make([]bool, len(baseCycles))
+
+	for _, targetCycle := range targetCycles {
+		var baseNodes []string
+		for i, baseCycle := range baseCycles {
+			if !sharesNode(baseCycle.Nodes, targetCycle.Nodes) {
+				continue
+			}
+			matchedBase[i] = true
+			baseNodes = append(baseNodes, baseCycle.Nodes...)
+		}
+		sort.Strings(baseNodes)
+
+		switch {
+		case len(baseNodes) == 0:
+			changes = append(changes, cycleChange{
+				kind:        cycleAdded,
+				path:        targetCycle.Path,
+				baseNodes:   []string{},
+				targetNodes: targetCycle.Nodes,
+			})
+		case !equalStrings(baseNodes, targetCycle.Nodes):
+			changes = append(changes, cycleChange{
+				kind:        cycleChanged,
+				path:        targetCycle.Path,
+				baseNodes:   baseNodes,
+				targetNodes: targetCycle.Nodes,
+			})
+		}
+	}
+
+	for i, baseCycle := range baseCycles {
+		if matchedBase[i] {
+			continue
+		}
+		changes = append(changes, cycleChange{
+			kind:        cycleRemoved,
+			path:        baseCycle.Path,
+			baseNodes:   baseCycle.Nodes,
+			targetNodes: []string{},
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].path[0] < changes[j].path[0]
+	})
+	return changes
+}
+
+func sharesNode(left, right []string) bool {
+	for _, n := range left {
+		if containsString(right, n) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(left, right []string) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if left[i] != right[i] {
+			return false
+		}
+	}
+	return true
+}
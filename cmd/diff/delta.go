@@ -9,10 +9,12 @@ import (
 	"github.com/LegacyCodeHQ/clarity/depgraph"
 )
 
-// SemanticAnalyzer computes optional semantic findings from two snapshots and their structural delta.
-type SemanticAnalyzer func(base, target depgraph.DependencyGraph, delta graphDelta) ([]string, error)
+// SemanticAnalyzer computes semantic findings from two snapshots and their structural delta.
+type SemanticAnalyzer func(base, target depgraph.DependencyGraph, delta graphDelta) ([]finding, error)
 
-func buildGraphDelta(base, target depgraph.DependencyGraph) (graphDelta, error) {
+// buildGraphDelta compares the snapshots. renames maps base paths to target paths of files git
+// detected as renamed; it may be nil.
+func buildGraphDelta(base, target depgraph.DependencyGraph, renames map[string]string) (graphDelta, error) {
 	baseAdj, err := depgraph.AdjacencyList(base)
 	if err != nil {
 		return graphDelta{}, fmt.Errorf("failed to read base adjacency: %w", err)
@@ -22,18 +24,28 @@ func buildGraphDelta(base, target depgraph.DependencyGraph) (graphDelta, error)
 		return graphDelta{}, fmt.Errorf("failed to read target adjacency: %w", err)
 	}
 
-	baseNodes := collectNodes(baseAdj)
 	targetNodes := collectNodes(targetAdj)
+	moves := pairMovedNodes(renames, collectNodes(baseAdj), targetNodes)
+	baseAdj = renameNodes(baseAdj, moves)
+	baseNodes := collectNodes(baseAdj)
 
 	delta := graphDelta{
 		nodesAdded:   setDifference(targetNodes, baseNodes),
 		nodesRemoved: setDifference(baseNodes, targetNodes),
 		edgesAdded:   edgeDifference(collectEdges(targetAdj), collectEdges(baseAdj)),
 		edgesRemoved: edgeDifference(collectEdges(baseAdj), collectEdges(targetAdj)),
+		baseAdj:      baseAdj,
+		targetAdj:    targetAdj,
+	}
+	for from, to := range moves {
+		delta.nodesMoved = append(delta.nodesMoved, nodeMove{from: from, to: to})
 	}
 
 	sort.Strings(delta.nodesAdded)
 	sort.Strings(delta.nodesRemoved)
+	sort.Slice(delta.nodesMoved, func(i, j int) bool {
+		return delta.nodesMoved[i].to < delta.nodesMoved[j].to
+	})
 	sort.Slice(delta.edgesAdded, func(i, j int) bool {
 		leftFrom := filepath.Clean(delta.edgesAdded[i].from)
 		rightFrom := filepath.Clean(delta.edgesAdded[j].from)
@@ -51,15 +63,85 @@ func buildGraphDelta(base, target depgraph.DependencyGraph) (graphDelta, error)
 		return leftFrom < rightFrom
 	})
 
+	targetCycles := depgraph.FindCycles(targetAdj)
+	delta.cycleBreaks = cycleBreaksForAddedEdges(targetAdj, targetCycles, delta.edgesAdded)
+	delta.cycleChanges = diffCycles(depgraph.FindCycles(baseAdj), targetCycles)
+
 	return delta, nil
 }
 
+// pairMovedNodes keeps the renames whose base path only exists in the base graph and whose target
+// path only exists in the target graph.
+func pairMovedNodes(renames map[string]string, baseNodes, targetNodes map[string]struct{}) map[string]string {
+	moves := make(map[string]string, len(renames))
+	for from, to := range renames {
+		_, fromInBase := baseNodes[from]
+		_, fromInTarget := targetNodes[from]
+		_, toInBase := baseNodes[to]
+		_, toInTarget := targetNodes[to]
+		if fromInBase && !fromInTarget && toInTarget && !toInBase {
+			moves[from] = to
+		}
+	}
+	return moves
+}
+
+// renameNodes rewrites the adjacency list with the target path of every moved node.
+func renameNodes(adj map[string][]string, moves map[string]string) map[string][]string {
+	if len(moves) == 0 {
+		return adj
+	}
+	rename := func(path string) string {
+		if to, ok := moves[path]; ok {
+			return to
+		}
+		return path
+	}
+
+	renamed := make(map[string][]string, len(adj))
+	for from, deps := range adj {
+		renamedDeps := make([]string, 0, len(deps))
+		for _, to := range deps {
+			renamedDeps = append(renamedDeps, rename(to))
+		}
+		renamed[rename(from)] = renamedDeps
+	}
+	return renamed
+}
+
+// cycleBreaksForAddedEdges returns the suggested breaks of every target cycle that an added edge is part of.
+func cycleBreaksForAddedEdges(targetAdj map[string][]string, targetCycles []depgraph.FileCycle, edgesAdded []graphEdge) []depgraph.CycleBreak {
+	if len(edgesAdded) == 0 {
+		return nil
+	}
+
+	var breaks []depgraph.CycleBreak
+	for _, cycle := range targetCycles {
+		for _, e := range edgesAdded {
+			if containsString(cycle.Nodes, e.from) && containsString(cycle.Nodes, e.to) {
+				breaks = append(breaks, depgraph.CycleBreaks(targetAdj, cycle)...)
+				break
+			}
+		}
+	}
+	return breaks
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
 func applySemanticAnalyzers(base, target depgraph.DependencyGraph, delta graphDelta, analyzers []SemanticAnalyzer) (graphDelta, error) {
 	if len(analyzers) == 0 {
 		return delta, nil
 	}
 
-	findings := []string{}
+	findings := []finding{}
 	for _, analyzer := range analyzers {
 		if analyzer == nil {
 			continue
@@ -70,7 +152,7 @@ func applySemanticAnalyzers(base, target depgraph.DependencyGraph, delta graphDe
 		}
 		findings = append(findings, semanticFindings...)
 	}
-	sort.Strings(findings)
+	sortFindings(findings)
 	delta.findings = findings
 	return delta, nil
 }
@@ -116,12 +198,24 @@ func edgeDifference(left, right map[graphEdge]struct{}) []graphEdge {
 	return result
 }
 
+// renderComparisonSummary prefixes the summary with what was compared.
+func renderComparisonSummary(delta graphDelta, comparison comparisonLabel) string {
+	if label := comparison.String(); label != "" {
+		return fmt.Sprintf("Comparing: %s\n%s", label, renderSummary(delta))
+	}
+	return renderSummary(delta)
+}
+
 func renderSummary(delta graphDelta) string {
 	var lines []string
 	lines = append(lines, fmt.Sprintf("Nodes added: %d", len(delta.nodesAdded)))
 	lines = append(lines, delta.nodesAdded...)
 	lines = append(lines, fmt.Sprintf("Nodes removed: %d", len(delta.nodesRemoved)))
 	lines = append(lines, delta.nodesRemoved...)
+	lines = append(lines, fmt.Sprintf("Nodes moved: %d", len(delta.nodesMoved)))
+	for _, move := range delta.nodesMoved {
+		lines = append(lines, fmt.Sprintf("%s -> %s", move.from, move.to))
+	}
 	lines = append(lines, fmt.Sprintf("Edges added: %d", len(delta.edgesAdded)))
 	for _, e := range delta.edgesAdded {
 		lines = append(lines, fmt.Sprintf("%s -> %s", e.from, e.to))
@@ -130,7 +224,17 @@ func renderSummary(delta graphDelta) string {
 	for _, e := range delta.edgesRemoved {
 		lines = append(lines, fmt.Sprintf("%s -> %s", e.from, e.to))
 	}
+	lines = append(lines, fmt.Sprintf("Cycle changes: %d", len(delta.cycleChanges)))
+	for _, change := range delta.cycleChanges {
+		lines = append(lines, fmt.Sprintf("%s: %s", change.kind, cyclePathLabel(change.path, delta.repoRoot)))
+	}
 	lines = append(lines, fmt.Sprintf("Semantic findings: %d", len(delta.findings)))
-	lines = append(lines, delta.findings...)
+	for _, f := range delta.findings {
+		lines = append(lines, f.String())
+	}
+	lines = append(lines, fmt.Sprintf("Suggested cycle breaks: %d", len(delta.cycleBreaks)))
+	for _, cycleBreak := range delta.cycleBreaks {
+		lines = append(lines, fmt.Sprintf("%s -> %s (%s)", cycleBreak.Edge.From, cycleBreak.Edge.To, depgraph.CycleCountLabel(cycleBreak.Cycles)))
+	}
 	return strings.Join(lines, "\n")
 }
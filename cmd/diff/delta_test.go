@@ -17,7 +17,7 @@ func TestBuildGraphDelta_ComputesAddedRemovedNodesAndEdges(t *testing.T) {
 		"/repo/c.go": {},
 	})
 
-	delta, err := buildGraphDelta(base, target)
+	delta, err := buildGraphDelta(base, target, nil)
 	if err != nil {
 		t.Fatalf("buildGraphDelta() error = %v", err)
 	}
@@ -37,6 +37,167 @@ func TestBuildGraphDelta_ComputesAddedRemovedNodesAndEdges(t *testing.T) {
 	}
 }
 
+func TestBuildGraphDelta_PairsRenamedFilesAsMovedNodes(t *testing.T) {
+	base := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go":     {"/repo/src/b.go"},
+		"/repo/src/b.go": {"/repo/c.go"},
+		"/repo/c.go":     {},
+	})
+	target := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go":     {"/repo/lib/b.go"},
+		"/repo/lib/b.go": {"/repo/c.go", "/repo/d.go"},
+		"/repo/c.go":     {},
+		"/repo/d.go":     {},
+	})
+	renames := map[string]string{
+		"/repo/src/b.go": "/repo/lib/b.go",
+		"/repo/gone.go":  "/repo/elsewhere.go",
+	}
+
+	delta, err := buildGraphDelta(base, target, renames)
+	if err != nil {
+		t.Fatalf("buildGraphDelta() error = %v", err)
+	}
+
+	if len(delta.nodesMoved) != 1 || delta.nodesMoved[0] != (nodeMove{from: "/repo/src/b.go", to: "/repo/lib/b.go"}) {
+		t.Fatalf("unexpected nodesMoved: %+v", delta.nodesMoved)
+	}
+	if len(delta.nodesAdded) != 1 || delta.nodesAdded[0] != "/repo/d.go" {
+		t.Fatalf("unexpected nodesAdded: %+v", delta.nodesAdded)
+	}
+	if len(delta.nodesRemoved) != 0 {
+		t.Fatalf("unexpected nodesRemoved: %+v", delta.nodesRemoved)
+	}
+	// Edges that only follow the move are unchanged; the new import is the only edge delta.
+	if len(delta.edgesAdded) != 1 || delta.edgesAdded[0] != (graphEdge{from: "/repo/lib/b.go", to: "/repo/d.go"}) {
+		t.Fatalf("unexpected edgesAdded: %+v", delta.edgesAdded)
+	}
+	if len(delta.edgesRemoved) != 0 {
+		t.Fatalf("unexpected edgesRemoved: %+v", delta.edgesRemoved)
+	}
+}
+
+func TestMoveLabel_DropsSharedDirectories(t *testing.T) {
+	got := moveLabel(nodeMove{from: "/repo/src/b.ts", to: "/repo/lib/b.ts"})
+	if got != "src/b.ts → lib/b.ts" {
+		t.Fatalf("moveLabel() = %q", got)
+	}
+}
+
+func TestBuildGraphDelta_SuggestsBreaksForCyclesWithAddedEdges(t *testing.T) {
+	base := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/b.go"},
+		"/repo/b.go": {},
+		"/repo/x.go": {"/repo/y.go"},
+		"/repo/y.go": {"/repo/x.go"},
+	})
+	target := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/b.go"},
+		"/repo/b.go": {"/repo/a.go"},
+		"/repo/x.go": {"/repo/y.go"},
+		"/repo/y.go": {"/repo/x.go"},
+	})
+
+	delta, err := buildGraphDelta(base, target, nil)
+	if err != nil {
+		t.Fatalf("buildGraphDelta() error = %v", err)
+	}
+
+	// The x.go <-> y.go cycle predates the change, so only the new cycle gets suggestions.
+	if len(delta.cycleBreaks) != 1 {
+		t.Fatalf("unexpected cycleBreaks: %+v", delta.cycleBreaks)
+	}
+	edge := delta.cycleBreaks[0].Edge
+	if edge.From != "/repo/b.go" && edge.From != "/repo/a.go" {
+		t.Fatalf("unexpected cycle break: %+v", edge)
+	}
+
+	dot := renderDeltaDOT(delta, comparisonLabel{})
+	if !strings.Contains(dot, "  // Suggested cycle breaks:\n") {
+		t.Fatalf("missing cycle breaks in DOT output:\n%s", dot)
+	}
+	if !strings.Contains(renderSummary(delta), "Suggested cycle breaks: 1") {
+		t.Fatalf("missing cycle breaks in summary:\n%s", renderSummary(delta))
+	}
+}
+
+func TestBuildGraphDelta_ReportsCycleChanges(t *testing.T) {
+	base := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/b.go"},
+		"/repo/b.go": {"/repo/a.go"},
+		"/repo/c.go": {},
+		"/repo/x.go": {"/repo/y.go"},
+		"/repo/y.go": {"/repo/x.go"},
+		"/repo/p.go": {"/repo/q.go"},
+		"/repo/q.go": {},
+	})
+	target := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/b.go"},
+		"/repo/b.go": {"/repo/c.go"},
+		"/repo/c.go": {"/repo/a.go"},
+		"/repo/x.go": {"/repo/y.go"},
+		"/repo/y.go": {},
+		"/repo/p.go": {"/repo/q.go"},
+		"/repo/q.go": {"/repo/p.go"},
+	})
+
+	delta, err := buildGraphDelta(base, target, nil)
+	if err != nil {
+		t.Fatalf("buildGraphDelta() error = %v", err)
+	}
+
+	if len(delta.cycleChanges) != 3 {
+		t.Fatalf("unexpected cycleChanges: %+v", delta.cycleChanges)
+	}
+	changed, added, removed := delta.cycleChanges[0], delta.cycleChanges[1], delta.cycleChanges[2]
+	if changed.kind != cycleChanged || strings.Join(changed.joinedNodes(), ",") != "/repo/c.go" {
+		t.Fatalf("unexpected changed cycle: %+v", changed)
+	}
+	if added.kind != cycleAdded || strings.Join(added.targetNodes, ",") != "/repo/p.go,/repo/q.go" || len(added.baseNodes) != 0 {
+		t.Fatalf("unexpected added cycle: %+v", added)
+	}
+	if removed.kind != cycleRemoved || strings.Join(removed.baseNodes, ",") != "/repo/x.go,/repo/y.go" || len(removed.targetNodes) != 0 {
+		t.Fatalf("unexpected removed cycle: %+v", removed)
+	}
+
+	delta.repoRoot = "/repo"
+	delta, err = applySemanticAnalyzers(base, target, delta, defaultSemanticAnalyzers)
+	if err != nil {
+		t.Fatalf("applySemanticAnalyzers() error = %v", err)
+	}
+	var got []string
+	for _, f := range delta.findings {
+		got = append(got, f.String())
+	}
+	want := []string{
+		"warning cycle-grown: import cycle now includes c.go: a.go -> b.go -> c.go -> a.go",
+		"note cycle-resolved: import cycle resolved: x.go -> y.go -> x.go",
+		"warning new-cycle: new import cycle: p.go -> q.go -> p.go",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected findings:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestBuildGraphDelta_MovedFilesKeepTheirCycle(t *testing.T) {
+	base := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/b.go"},
+		"/repo/b.go": {"/repo/a.go"},
+	})
+	target := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go":     {"/repo/lib/b.go"},
+		"/repo/lib/b.go": {"/repo/a.go"},
+	})
+
+	delta, err := buildGraphDelta(base, target, map[string]string{"/repo/b.go": "/repo/lib/b.go"})
+	if err != nil {
+		t.Fatalf("buildGraphDelta() error = %v", err)
+	}
+	if len(delta.cycleChanges) != 0 {
+		t.Fatalf("moving a file should not change its cycle: %+v", delta.cycleChanges)
+	}
+}
+
 func TestRenderSummary_DeterministicOrder(t *testing.T) {
 	delta := graphDelta{
 		nodesAdded:   []string{"/repo/z.go", "/repo/a.go"},
@@ -47,11 +208,11 @@ func TestRenderSummary_DeterministicOrder(t *testing.T) {
 		edgesRemoved: []graphEdge{
 			{from: "/repo/c.go", to: "/repo/a.go"},
 		},
-		findings: []string{"new cycle in /repo/z.go"},
+		findings: []finding{{ruleID: ruleNewCycle, severity: severityWarning, message: "new import cycle: z.go -> a.go -> z.go"}},
 	}
 
 	out := renderSummary(delta)
-	wantSections := []string{"Nodes added:", "Nodes removed:", "Edges added:", "Edges removed:", "Semantic findings:"}
+	wantSections := []string{"Nodes added:", "Nodes removed:", "Nodes moved:", "Edges added:", "Edges removed:", "Semantic findings:"}
 	lastIndex := -1
 	for _, section := range wantSections {
 		idx := strings.Index(out, section)
@@ -71,11 +232,11 @@ func TestApplySemanticAnalyzers_SortedAndAggregated(t *testing.T) {
 	delta := graphDelta{}
 
 	analyzers := []SemanticAnalyzer{
-		func(base, target depgraph.DependencyGraph, delta graphDelta) ([]string, error) {
-			return []string{"b-finding"}, nil
+		func(base, target depgraph.DependencyGraph, delta graphDelta) ([]finding, error) {
+			return []finding{{ruleID: "rule", message: "b-finding"}}, nil
 		},
-		func(base, target depgraph.DependencyGraph, delta graphDelta) ([]string, error) {
-			return []string{"a-finding"}, nil
+		func(base, target depgraph.DependencyGraph, delta graphDelta) ([]finding, error) {
+			return []finding{{ruleID: "rule", message: "a-finding"}}, nil
 		},
 	}
 
@@ -86,7 +247,7 @@ func TestApplySemanticAnalyzers_SortedAndAggregated(t *testing.T) {
 	if len(out.findings) != 2 {
 		t.Fatalf("expected 2 findings, got %d", len(out.findings))
 	}
-	if out.findings[0] != "a-finding" || out.findings[1] != "b-finding" {
+	if out.findings[0].message != "a-finding" || out.findings[1].message != "b-finding" {
 		t.Fatalf("findings are not sorted: %+v", out.findings)
 	}
 }
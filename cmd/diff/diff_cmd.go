@@ -1,22 +1,32 @@
 package diff
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
 	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/internal/graphflags"
+	"github.com/LegacyCodeHQ/clarity/vcs"
 	"github.com/LegacyCodeHQ/clarity/vcs/git"
 	"github.com/spf13/cobra"
 )
 
 var snapshotSelectorFlags = []string{"staged", "unstaged", "untracked"}
 
+var errMergeBaseRequiresPair = errors.New("--merge-base requires --commit <A>,<B>")
+
 type diffOptions struct {
 	repoPath   string
 	outputFmt  string
 	summary    bool
 	commitSpec string
+	layout     string
+	impact     bool
+	failOn     []string
+	// buildOptions configures optional dependency-graph analysis of both snapshots.
+	buildOptions depgraph.BuildOptions
 }
 
 // Cmd represents the diff command.
@@ -26,29 +36,55 @@ var Cmd = NewCommand()
 func NewCommand() *cobra.Command {
 	opts := &diffOptions{
 		outputFmt: formatters.OutputFormatDOT.String(),
+		layout:    string(layoutMerged),
 	}
 
 	cmd := &cobra.Command{
 		Use:   "diff",
 		Short: "Show dependency-graph changes between snapshots",
-		Long:  "Show dependency-graph changes between snapshots.",
+		Long: `Show dependency-graph changes between snapshots.
+
+Without --commit, the working tree is compared with HEAD. --staged, --unstaged and
+--untracked narrow the comparison to specific layers of uncommitted work:
+
+  --staged                 HEAD compared with the index (what the next commit contains)
+  --unstaged               the index compared with tracked files in the working tree
+  --untracked              tracked files compared with tracked and untracked files
+  --staged --unstaged      HEAD compared with tracked files in the working tree
+  --unstaged --untracked   the index compared with the full working tree
+
+--commit accepts anything git resolves to a commit: hashes, branches, tags, stash@{n}
+or HEAD@{yesterday}. A single ref is compared with its first parent, so --commit stash@{0}
+shows what a stash changed. --commit A,B compares A with B; add --merge-base to compare
+B with the point where it forked from A, like git diff A...B.
+
+Every output format names the compared snapshots.
+
+--fail-on makes CI block changes: the delta is still written, but clarity exits with
+status 2 when a policy is violated, while errors exit with 1.
+
+  --fail-on new-cycle                           a new import cycle (any finding rule ID works)
+  --fail-on 'edge-added:src/ui/**->src/db/**'  an added dependency between repository paths
+  --fail-on 'fan-in>20'                        a file that gained dependents now has more than 20`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runDiff(cmd, opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.repoPath, "repo", "r", "", "Git repository path (default: current directory)")
-	cmd.Flags().StringVarP(&opts.outputFmt, "format", "f", opts.outputFmt, fmt.Sprintf("Output format (%s)", formatters.SupportedFormats()))
+	cmd.Flags().StringVarP(&opts.outputFmt, "format", "f", opts.outputFmt, fmt.Sprintf("Output format (%s)", supportedDiffFormats()))
 	cmd.Flags().BoolVar(&opts.summary, "summary", false, "Print text summary only")
-	cmd.Flags().StringVarP(&opts.commitSpec, "commit", "c", "", "Compare committed snapshots (<commit> or <A>,<B>)")
-
-	// Reserved snapshot selectors for future working-tree controls.
-	cmd.Flags().Bool("staged", false, "Include staged changes")
-	_ = cmd.Flags().MarkHidden("staged")
-	cmd.Flags().Bool("unstaged", false, "Include unstaged changes")
-	_ = cmd.Flags().MarkHidden("unstaged")
-	cmd.Flags().Bool("untracked", false, "Include untracked changes")
-	_ = cmd.Flags().MarkHidden("untracked")
+	cmd.Flags().StringVarP(&opts.commitSpec, "commit", "c", "", "Compare committed snapshots (<ref> or <A>,<B>)")
+	cmd.Flags().Bool("merge-base", false, "With --commit <A>,<B>, compare B with the merge-base of A and B")
+	cmd.Flags().StringVar(&opts.layout, "layout", opts.layout, fmt.Sprintf("Graph layout (%s)", supportedDiffLayouts()))
+	cmd.Flags().BoolVar(&opts.impact, "impact", false, "Keep only changed nodes and their one-hop neighbors")
+	graphflags.Register(cmd, &opts.buildOptions)
+	cmd.Flags().StringArrayVar(&opts.failOn, "fail-on", nil, fmt.Sprintf("Exit with status %d when the delta violates a policy (%s; repeatable)", ExitCodePolicyViolation, supportedFailPolicies()))
+
+	// Working-tree snapshot selectors
+	cmd.Flags().Bool("staged", false, "Include staged changes (HEAD compared with the index)")
+	cmd.Flags().Bool("unstaged", false, "Include unstaged changes to tracked files")
+	cmd.Flags().Bool("untracked", false, "Include untracked files")
 
 	return cmd
 }
@@ -59,6 +95,19 @@ func runDiff(cmd *cobra.Command, opts *diffOptions) error {
 		repoPath = "."
 	}
 
+	layout, err := parseDiffLayout(opts.layout)
+	if err != nil {
+		return err
+	}
+	if layout == layoutOverlay && cmd.Flags().Changed("format") {
+		return fmt.Errorf("--layout %s writes HTML and cannot be combined with --format", layoutOverlay)
+	}
+	renderOpts := diffRenderOptions{layout: layout, impact: opts.impact}
+	policies, err := parseFailPolicies(opts.failOn)
+	if err != nil {
+		return err
+	}
+
 	comparison, err := resolveModeAndCommitComparison(cmd, repoPath, opts.commitSpec)
 	if err != nil {
 		return err
@@ -68,86 +117,181 @@ func runDiff(cmd *cobra.Command, opts *diffOptions) error {
 	if err != nil {
 		return err
 	}
+	renderOpts.comparison = newComparisonLabel(snapshots)
+	renderOpts.repoRoot, err = git.GetRepositoryRoot(repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve repository root: %w", err)
+	}
+	graphflags.ResolveRoots(&opts.buildOptions, repoPath)
 
-	baseGraph, err := buildGraphFromSnapshot(snapshots.base)
+	baseGraph, err := buildGraphFromSnapshot(snapshots.base, opts.buildOptions)
 	if err != nil {
 		return fmt.Errorf("failed to build base dependency graph: %w", err)
 	}
-	targetGraph, err := buildGraphFromSnapshot(snapshots.target)
+	targetGraph, err := buildGraphFromSnapshot(snapshots.target, opts.buildOptions)
 	if err != nil {
 		return fmt.Errorf("failed to build target dependency graph: %w", err)
 	}
 
-	delta, err := buildGraphDelta(baseGraph, targetGraph)
+	renames, err := resolveRenames(repoPath, comparison, snapshots)
+	if err != nil {
+		return err
+	}
+	delta, err := buildGraphDelta(baseGraph, targetGraph, renames)
 	if err != nil {
 		return err
 	}
-	delta.changedNodes, err = resolveChangedNodes(repoPath, comparison)
+	delta.repoRoot = renderOpts.repoRoot
+	delta.changedNodes, delta.changedStats, err = resolveChangedNodes(repoPath, comparison, snapshots)
 	if err != nil {
 		return err
 	}
-	delta, err = applySemanticAnalyzers(baseGraph, targetGraph, delta, nil)
+	delta, err = applySemanticAnalyzers(baseGraph, targetGraph, delta, defaultSemanticAnalyzers)
 	if err != nil {
 		return fmt.Errorf("failed to compute semantic findings: %w", err)
 	}
 
 	if opts.summary {
-		fmt.Fprintln(cmd.OutOrStdout(), renderSummary(delta))
-		return nil
+		fmt.Fprintln(cmd.OutOrStdout(), renderComparisonSummary(delta, renderOpts.comparison))
+	} else {
+		out, err := renderDelta(opts.outputFmt, delta, renderOpts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), out)
 	}
 
-	out, err := renderDelta(opts.outputFmt, delta)
-	if err != nil {
-		return err
+	if violations := evaluateFailPolicies(policies, delta, renderOpts.repoRoot); len(violations) > 0 {
+		// A violation is a verdict on the change, not a usage mistake.
+		cmd.SilenceUsage = true
+		return &PolicyViolationError{Violations: violations}
 	}
-	fmt.Fprintln(cmd.OutOrStdout(), out)
-
 	return nil
 }
 
-func buildGraphFromSnapshot(s snapshot) (depgraph.DependencyGraph, error) {
+func buildGraphFromSnapshot(s snapshot, buildOptions depgraph.BuildOptions) (depgraph.DependencyGraph, error) {
 	if len(s.filePaths) == 0 {
 		return depgraph.NewDependencyGraph(), nil
 	}
 	if s.contentRead == nil {
 		return nil, fmt.Errorf("content reader is required for non-empty snapshot %q", s.ref)
 	}
-	return depgraph.BuildDependencyGraph(s.filePaths, s.contentRead)
+	built, err := depgraph.BuildDependencyGraphWithOptions(s.filePaths, s.contentRead, buildOptions)
+	return built.Graph, err
 }
 
-func resolveChangedNodes(repoPath string, comparison commitComparison) (map[string]struct{}, error) {
+// resolveChangedNodes returns the files of the target snapshot that changed between the
+// snapshots. Renamed files are reported under their new path.
+func resolveChangedNodes(repoPath string, comparison commitComparison, snapshots snapshotPair) (map[string]struct{}, map[string]vcs.FileStats, error) {
 	var (
-		changed []string
-		err     error
+		stats map[string]vcs.FileStats
+		err   error
 	)
 
 	switch comparison.mode {
 	case diffModeWorkingTree:
-		changed, err = git.GetUncommittedFiles(repoPath)
+		if comparison.selection != (snapshotSelection{}) {
+			changed, err := workingTreeChangedFiles(repoPath, comparison.selection)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to resolve changed files: %w", err)
+			}
+			changedSet := make(map[string]struct{}, len(changed))
+			for _, path := range changed {
+				changedSet[path] = struct{}{}
+			}
+			return intersectTargetFiles(changedSet, snapshots.target), nil, nil
+		}
+		stats, err = git.GetUncommittedFileStats(repoPath)
 	case diffModeCommit:
-		if comparison.baseRef != "" {
-			changed, err = git.GetCommitRangeFiles(repoPath, comparison.baseRef, comparison.targetRef)
+		if snapshots.base.ref == emptySnapshotRef {
+			stats, err = git.GetCommitFileStats(repoPath, comparison.targetRef)
 		} else {
-			changed, err = git.GetCommitDartFiles(repoPath, comparison.targetRef)
+			stats, err = git.GetCommitRangeFileStats(repoPath, snapshots.base.ref, comparison.targetRef)
 		}
 	default:
-		return nil, fmt.Errorf("unknown diff mode: %s", comparison.mode)
+		return nil, nil, fmt.Errorf("unknown diff mode: %s", comparison.mode)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve changed files: %w", err)
+		return nil, nil, fmt.Errorf("failed to resolve changed files: %w", err)
 	}
 
-	changedSet := make(map[string]struct{}, len(changed))
-	for _, path := range changed {
+	changedSet := make(map[string]struct{}, len(stats))
+	for path := range stats {
 		changedSet[path] = struct{}{}
 	}
-	return changedSet, nil
+	changedSet = intersectTargetFiles(changedSet, snapshots.target)
+	for path := range stats {
+		if _, ok := changedSet[path]; !ok {
+			delete(stats, path)
+		}
+	}
+	return changedSet, stats, nil
+}
+
+// resolveRenames returns the files git detected as renamed between the snapshots, keyed by their
+// base path. Comparisons whose base is not HEAD or a commit report no renames.
+func resolveRenames(repoPath string, comparison commitComparison, snapshots snapshotPair) (map[string]string, error) {
+	var (
+		renames map[string]string
+		err     error
+	)
+
+	switch comparison.mode {
+	case diffModeWorkingTree:
+		baseLayer, targetLayer := comparison.selection.layers()
+		switch {
+		case baseLayer != layerHead:
+			return nil, nil
+		case targetLayer == layerIndex:
+			renames, err = git.GetStagedRenames(repoPath)
+		default:
+			renames, err = git.GetUncommittedRenames(repoPath)
+		}
+	case diffModeCommit:
+		if snapshots.base.ref == emptySnapshotRef {
+			return nil, nil
+		}
+		renames, err = git.GetCommitRangeRenames(repoPath, snapshots.base.ref, comparison.targetRef)
+	default:
+		return nil, fmt.Errorf("unknown diff mode: %s", comparison.mode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve renamed files: %w", err)
+	}
+	return renames, nil
+}
+
+// intersectTargetFiles drops changed files that are absent from the target snapshot, such as
+// deleted files, which the delta already reports as removed nodes.
+func intersectTargetFiles(changed map[string]struct{}, target snapshot) map[string]struct{} {
+	targetFiles := make(map[string]struct{}, len(target.filePaths))
+	for _, path := range target.filePaths {
+		targetFiles[path] = struct{}{}
+	}
+	for path := range changed {
+		if _, ok := targetFiles[path]; !ok {
+			delete(changed, path)
+		}
+	}
+	return changed
 }
 
 func resolveModeAndCommitComparison(cmd *cobra.Command, repoPath, commitSpec string) (commitComparison, error) {
+	mergeBase, err := cmd.Flags().GetBool("merge-base")
+	if err != nil {
+		return commitComparison{}, err
+	}
+
 	trimmedCommit := strings.TrimSpace(commitSpec)
 	if trimmedCommit == "" {
-		return commitComparison{mode: diffModeWorkingTree}, nil
+		if mergeBase {
+			return commitComparison{}, errMergeBaseRequiresPair
+		}
+		selection, err := parseSnapshotSelection(cmd)
+		if err != nil {
+			return commitComparison{}, err
+		}
+		return commitComparison{mode: diffModeWorkingTree, selection: selection}, nil
 	}
 
 	if err := validateCommitModeConflicts(cmd); err != nil {
@@ -159,21 +303,37 @@ func resolveModeAndCommitComparison(cmd *cobra.Command, repoPath, commitSpec str
 		return commitComparison{}, err
 	}
 
+	targetCommit, err := resolveRef(repoPath, targetRef)
+	if err != nil {
+		return commitComparison{}, err
+	}
 	if baseRef == "" {
-		if err := git.ValidateCommit(repoPath, targetRef); err != nil {
-			return commitComparison{}, err
+		if mergeBase {
+			return commitComparison{}, errMergeBaseRequiresPair
 		}
-		return commitComparison{baseRef: "", targetRef: targetRef, mode: diffModeCommit}, nil
+		return commitComparison{targetRef: targetCommit, targetName: targetRef, mode: diffModeCommit}, nil
 	}
 
-	if err := git.ValidateCommit(repoPath, baseRef); err != nil {
+	baseCommit, err := resolveRef(repoPath, baseRef)
+	if err != nil {
 		return commitComparison{}, err
 	}
-	if err := git.ValidateCommit(repoPath, targetRef); err != nil {
-		return commitComparison{}, err
+	baseName := baseRef
+	if mergeBase {
+		baseCommit, err = git.GetMergeBase(repoPath, baseCommit, targetCommit)
+		if err != nil {
+			return commitComparison{}, err
+		}
+		baseName = fmt.Sprintf("merge-base of %s and %s", baseRef, targetRef)
 	}
 
-	return commitComparison{baseRef: baseRef, targetRef: targetRef, mode: diffModeCommit}, nil
+	return commitComparison{
+		baseRef:    baseCommit,
+		targetRef:  targetCommit,
+		baseName:   baseName,
+		targetName: targetRef,
+		mode:       diffModeCommit,
+	}, nil
 }
 
 func validateCommitModeConflicts(cmd *cobra.Command) error {
@@ -186,6 +346,27 @@ func validateCommitModeConflicts(cmd *cobra.Command) error {
 	return nil
 }
 
+func parseSnapshotSelection(cmd *cobra.Command) (snapshotSelection, error) {
+	selected := make(map[string]bool, len(snapshotSelectorFlags))
+	for _, flagName := range snapshotSelectorFlags {
+		value, err := cmd.Flags().GetBool(flagName)
+		if err != nil {
+			return snapshotSelection{}, err
+		}
+		selected[flagName] = value
+	}
+
+	selection := snapshotSelection{
+		staged:    selected["staged"],
+		unstaged:  selected["unstaged"],
+		untracked: selected["untracked"],
+	}
+	if selection.staged && selection.untracked && !selection.unstaged {
+		return snapshotSelection{}, fmt.Errorf("--staged and --untracked require --unstaged to form a contiguous comparison")
+	}
+	return selection, nil
+}
+
 func parseCommitSpec(commitSpec string) (baseRef string, targetRef string, err error) {
 	if commitSpec == "" {
 		return "", "", fmt.Errorf("--commit requires a value")
@@ -209,10 +390,13 @@ func parseCommitSpec(commitSpec string) (baseRef string, targetRef string, err e
 	return left, right, nil
 }
 
-func renderDelta(format string, delta graphDelta) (string, error) {
+func renderDelta(format string, delta graphDelta, opts diffRenderOptions) (string, error) {
+	if opts.layout == layoutOverlay {
+		return overlayDiffFormatter{}.Format(delta, opts)
+	}
 	formatter, err := NewDiffFormatter(format)
 	if err != nil {
 		return "", err
 	}
-	return formatter.Format(delta)
+	return formatter.Format(delta, opts)
 }
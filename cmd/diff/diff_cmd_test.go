@@ -2,6 +2,7 @@ package diff
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -58,6 +59,65 @@ func TestDiffCommitPair_ValidRefsWithWhitespace(t *testing.T) {
 	}
 }
 
+func TestDiffCommitPair_ResolvesBranchNamesAndKeepsThemForLabels(t *testing.T) {
+	repoDir, firstCommit, secondCommit := initGitRepoWithTwoCommits(t)
+	gitRun(t, repoDir, "tag", "v1", firstCommit)
+
+	cmd := NewCommand()
+	comparison, err := resolveModeAndCommitComparison(cmd, repoDir, "v1,HEAD")
+	if err != nil {
+		t.Fatalf("resolveModeAndCommitComparison() error = %v", err)
+	}
+	if comparison.baseRef != firstCommit || comparison.targetRef != secondCommit {
+		t.Fatalf("expected refs to resolve to %s,%s, got %s,%s", firstCommit, secondCommit, comparison.baseRef, comparison.targetRef)
+	}
+	if comparison.baseName != "v1" || comparison.targetName != "HEAD" {
+		t.Fatalf("expected names v1,HEAD, got %s,%s", comparison.baseName, comparison.targetName)
+	}
+}
+
+func TestDiffCommitPair_MergeBaseComparesWithForkPoint(t *testing.T) {
+	repoDir, firstCommit, _ := initGitRepoWithTwoCommits(t)
+	gitRun(t, repoDir, "branch", "-M", "trunk")
+	gitRun(t, repoDir, "checkout", "-q", "-b", "feature", firstCommit)
+	if err := os.WriteFile(filepath.Join(repoDir, "feature.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	gitRun(t, repoDir, "add", "feature.go")
+	gitRun(t, repoDir, "commit", "-m", "feature commit")
+	featureCommit := strings.TrimSpace(gitOutput(t, repoDir, "rev-parse", "HEAD"))
+
+	cmd := NewCommand()
+	if err := cmd.Flags().Set("merge-base", "true"); err != nil {
+		t.Fatalf("set merge-base flag: %v", err)
+	}
+	comparison, err := resolveModeAndCommitComparison(cmd, repoDir, "trunk,feature")
+	if err != nil {
+		t.Fatalf("resolveModeAndCommitComparison() error = %v", err)
+	}
+	if comparison.baseRef != firstCommit || comparison.targetRef != featureCommit {
+		t.Fatalf("expected %s,%s, got %s,%s", firstCommit, featureCommit, comparison.baseRef, comparison.targetRef)
+	}
+	if comparison.baseName != "merge-base of trunk and feature" {
+		t.Fatalf("unexpected base name %q", comparison.baseName)
+	}
+}
+
+func TestDiffMergeBaseRequiresCommitPair(t *testing.T) {
+	repoDir, head := initGitRepoWithSingleCommit(t)
+
+	for _, commitSpec := range []string{"", head} {
+		cmd := NewCommand()
+		if err := cmd.Flags().Set("merge-base", "true"); err != nil {
+			t.Fatalf("set merge-base flag: %v", err)
+		}
+		_, err := resolveModeAndCommitComparison(cmd, repoDir, commitSpec)
+		if err == nil || !strings.Contains(err.Error(), "--merge-base requires --commit <A>,<B>") {
+			t.Fatalf("commit %q: expected merge-base error, got: %v", commitSpec, err)
+		}
+	}
+}
+
 func TestDiffCommitPairRejectsExtraCommas(t *testing.T) {
 	repoDir, firstCommit, secondCommit := initGitRepoWithTwoCommits(t)
 
@@ -117,6 +177,63 @@ func TestDiffCommitConflictsWithSnapshotSelector(t *testing.T) {
 	}
 }
 
+func TestDiffSnapshotSelectors_CompareWorkingTreeLayers(t *testing.T) {
+	repoDir := initGitRepoWithLayeredChanges(t)
+	edge := func(from, to string) string {
+		return filepath.Join(repoDir, from) + " -> " + filepath.Join(repoDir, to)
+	}
+
+	tests := []struct {
+		name      string
+		selectors []string
+		want      []string
+	}{
+		{name: "staged", selectors: []string{"--staged"}, want: []string{edge("a.ts", "b.ts")}},
+		{name: "unstaged", selectors: []string{"--unstaged"}, want: []string{edge("b.ts", "d.ts")}},
+		{name: "untracked", selectors: []string{"--untracked"}, want: []string{edge("c.ts", "a.ts")}},
+		{name: "staged and unstaged", selectors: []string{"--staged", "--unstaged"}, want: []string{edge("a.ts", "b.ts"), edge("b.ts", "d.ts")}},
+		{name: "default", selectors: nil, want: []string{edge("a.ts", "b.ts"), edge("b.ts", "d.ts"), edge("c.ts", "a.ts")}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := NewCommand()
+			cmd.SetArgs(append([]string{"--summary", "--repo", repoDir}, tc.selectors...))
+
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("cmd.Execute() error = %v", err)
+			}
+
+			summary := out.String()
+			if !strings.Contains(summary, fmt.Sprintf("Edges added: %d\n", len(tc.want))) {
+				t.Fatalf("expected %d added edges, got:\n%s", len(tc.want), summary)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(summary, want+"\n") {
+					t.Fatalf("expected added edge %q, got:\n%s", want, summary)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffSnapshotSelectors_StagedWithUntrackedRequiresUnstaged(t *testing.T) {
+	cmd := NewCommand()
+	if err := cmd.Flags().Set("staged", "true"); err != nil {
+		t.Fatalf("set staged flag: %v", err)
+	}
+	if err := cmd.Flags().Set("untracked", "true"); err != nil {
+		t.Fatalf("set untracked flag: %v", err)
+	}
+
+	_, err := resolveModeAndCommitComparison(cmd, ".", "")
+	if err == nil || !strings.Contains(err.Error(), "--staged and --untracked require --unstaged") {
+		t.Fatalf("expected non-contiguous selector error, got: %v", err)
+	}
+}
+
 func TestDiffCommandAcceptsSummaryFlag(t *testing.T) {
 	repoDir, _ := initGitRepoWithSingleCommit(t)
 
@@ -131,8 +248,22 @@ func TestDiffCommandAcceptsSummaryFlag(t *testing.T) {
 	}
 }
 
+func TestDiffOverlayLayoutRejectsFormat(t *testing.T) {
+	repoDir, _ := initGitRepoWithSingleCommit(t)
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--repo", repoDir, "--layout", "overlay", "--format", "mermaid"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--layout overlay writes HTML and cannot be combined with --format") {
+		t.Fatalf("expected overlay format error, got: %v", err)
+	}
+}
+
 func TestRenderDelta_UnsupportedFormat(t *testing.T) {
-	_, err := renderDelta("json", graphDelta{})
+	_, err := renderDelta("yaml", graphDelta{}, diffRenderOptions{})
 	if err == nil {
 		t.Fatal("expected unsupported format error")
 	}
@@ -154,7 +285,7 @@ func TestRenderDelta_Mermaid_UnchangedNodesUseDottedBorder(t *testing.T) {
 		},
 	}
 
-	out, err := renderDelta("mermaid", delta)
+	out, err := renderDelta("mermaid", delta, diffRenderOptions{})
 	if err != nil {
 		t.Fatalf("renderDelta(mermaid) error = %v", err)
 	}
@@ -200,6 +331,34 @@ func initGitRepoWithTwoCommits(t *testing.T) (repoDir string, firstCommit string
 	return repoDir, firstCommit, secondCommit
 }
 
+// initGitRepoWithLayeredChanges commits a.ts, b.ts and d.ts without dependencies, then adds
+// a.ts -> b.ts in the index, b.ts -> d.ts in the working tree only, and an untracked c.ts -> a.ts.
+func initGitRepoWithLayeredChanges(t *testing.T) string {
+	t.Helper()
+
+	repoDir := t.TempDir()
+	gitInitRepo(t, repoDir)
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	writeFile("a.ts", "export const a = 1;\n")
+	writeFile("b.ts", "export const b = 1;\n")
+	writeFile("d.ts", "export const d = 1;\n")
+	gitRun(t, repoDir, "add", ".")
+	gitRun(t, repoDir, "commit", "-m", "initial commit")
+
+	writeFile("a.ts", "import { b } from './b';\nexport const a = b;\n")
+	gitRun(t, repoDir, "add", "a.ts")
+	writeFile("b.ts", "import { d } from './d';\nexport const b = d;\n")
+	writeFile("c.ts", "import { a } from './a';\nexport const c = a;\n")
+
+	return repoDir
+}
+
 func gitInitRepo(t *testing.T, repoDir string) {
 	t.Helper()
 
@@ -7,8 +7,21 @@ const (
 	diffModeCommit      diffMode = "commit"
 )
 
+// commitComparison holds the commits compared in commit mode. baseRef and targetRef are resolved
+// commit hashes; baseName and targetName keep the refs as the user wrote them for labels.
 type commitComparison struct {
-	baseRef   string
-	targetRef string
-	mode      diffMode
+	baseRef    string
+	targetRef  string
+	baseName   string
+	targetName string
+	mode       diffMode
+	selection  snapshotSelection
+}
+
+// snapshotSelection records which working-tree changes --staged, --unstaged and --untracked
+// select. Selecting none of them is the same as selecting all of them.
+type snapshotSelection struct {
+	staged    bool
+	unstaged  bool
+	untracked bool
 }
@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// findingSeverity grades a finding. The values match SARIF result levels.
+type findingSeverity string
+
+const (
+	severityError   findingSeverity = "error"
+	severityWarning findingSeverity = "warning"
+	severityNote    findingSeverity = "note"
+)
+
+// finding is one semantic observation about a delta, reported under a stable rule ID.
+type finding struct {
+	ruleID   string
+	severity findingSeverity
+	message  string
+	// paths lists the files the finding is about; the first is the primary location.
+	paths []string
+}
+
+func (f finding) String() string {
+	return fmt.Sprintf("%s %s: %s", f.severity, f.ruleID, f.message)
+}
+
+// findingRule documents a rule for the formats that list rules, such as SARIF.
+type findingRule struct {
+	id          string
+	severity    findingSeverity
+	description string
+}
+
+// Rule IDs are part of the output schema; rename them only with a schema version bump.
+const (
+	ruleNewCycle      = "new-cycle"
+	ruleCycleGrown    = "cycle-grown"
+	ruleCycleResolved = "cycle-resolved"
+)
+
+var findingRules = []findingRule{
+	{id: ruleNewCycle, severity: severityWarning, description: "The change introduces an import cycle."},
+	{id: ruleCycleGrown, severity: severityWarning, description: "The change pulls more files into an existing import cycle."},
+	{id: ruleCycleResolved, severity: severityNote, description: "The change breaks an import cycle."},
+}
+
+// defaultSemanticAnalyzers run on every diff.
+var defaultSemanticAnalyzers = []SemanticAnalyzer{analyzeCycleChanges}
+
+// analyzeCycleChanges reports introduced, grown and resolved import cycles.
+func analyzeCycleChanges(_, _ depgraph.DependencyGraph, delta graphDelta) ([]finding, error) {
+	var findings []finding
+	for _, change := range delta.cycleChanges {
+		switch change.kind {
+		case cycleAdded:
+			findings = append(findings, finding{
+				ruleID:   ruleNewCycle,
+				severity: severityWarning,
+				message:  fmt.Sprintf("new import cycle: %s", cyclePathLabel(change.path, delta.repoRoot)),
+				paths:    change.path,
+			})
+		case cycleChanged:
+			joined := change.joinedNodes()
+			if len(joined) == 0 {
+				continue
+			}
+			findings = append(findings, finding{
+				ruleID:   ruleCycleGrown,
+				severity: severityWarning,
+				message:  fmt.Sprintf("import cycle now includes %s: %s", strings.Join(repoRelativePaths(joined, delta.repoRoot), ", "), cyclePathLabel(change.path, delta.repoRoot)),
+				paths:    joined,
+			})
+		case cycleRemoved:
+			findings = append(findings, finding{
+				ruleID:   ruleCycleResolved,
+				severity: severityNote,
+				message:  fmt.Sprintf("import cycle resolved: %s", cyclePathLabel(change.path, delta.repoRoot)),
+				paths:    change.path,
+			})
+		}
+	}
+	return findings, nil
+}
+
+// cyclePathLabel closes a cycle path back on its first file, naming files relative to the
+// repository root: "a.go -> b.go -> a.go".
+func cyclePathLabel(path []string, repoRoot string) string {
+	if len(path) == 0 {
+		return ""
+	}
+	rel := repoRelativePaths(path, repoRoot)
+	return strings.Join(append(rel, rel[0]), " -> ")
+}
+
+func sortFindings(findings []finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].ruleID != findings[j].ruleID {
+			return findings[i].ruleID < findings[j].ruleID
+		}
+		return findings[i].message < findings[j].message
+	})
+}
@@ -2,24 +2,47 @@ package diff
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
 )
 
 // Formatter renders a dependency graph delta into a concrete output format.
 type Formatter interface {
-	Format(delta graphDelta) (string, error)
+	Format(delta graphDelta, opts diffRenderOptions) (string, error)
 }
 
 type dotDiffFormatter struct{}
 
 type mermaidDiffFormatter struct{}
 
+type textDiffFormatter struct{}
+
+type jsonDiffFormatter struct{}
+
+type sarifDiffFormatter struct{}
+
+// overlayDiffFormatter writes the overlay layout, which is always HTML.
+type overlayDiffFormatter struct{}
+
+// formatJSON and formatSARIF are diff-only formats; show has no renderer for them.
+const (
+	formatJSON  = "json"
+	formatSARIF = "sarif"
+)
+
 // NewDiffFormatter constructs a formatter for the requested output format.
 func NewDiffFormatter(format string) (Formatter, error) {
+	if strings.EqualFold(format, formatJSON) {
+		return jsonDiffFormatter{}, nil
+	}
+	if strings.EqualFold(format, formatSARIF) {
+		return sarifDiffFormatter{}, nil
+	}
+
 	parsed, ok := formatters.ParseOutputFormat(format)
 	if !ok {
-		return nil, fmt.Errorf("unknown format: %s (valid options: %s)", format, formatters.SupportedFormats())
+		return nil, fmt.Errorf("unknown format: %s (valid options: %s)", format, supportedDiffFormats())
 	}
 
 	switch parsed {
@@ -27,7 +50,25 @@ func NewDiffFormatter(format string) (Formatter, error) {
 		return dotDiffFormatter{}, nil
 	case formatters.OutputFormatMermaid:
 		return mermaidDiffFormatter{}, nil
+	case formatters.OutputFormatText:
+		return textDiffFormatter{}, nil
 	default:
-		return nil, fmt.Errorf("unknown format: %s (valid options: %s)", format, formatters.SupportedFormats())
+		return nil, fmt.Errorf("unsupported format for diff: %s (valid options: %s)", format, supportedDiffFormats())
 	}
 }
+
+// errLayoutUnsupported reports layout options that a summary format cannot honor.
+func errLayoutUnsupported(format string) error {
+	return fmt.Errorf("--layout and --impact apply to dot and mermaid output, not %s", format)
+}
+
+// supportedDiffFormats lists the show formats that have a diff renderer.
+func supportedDiffFormats() string {
+	return strings.Join([]string{
+		formatters.OutputFormatDOT.String(),
+		formatters.OutputFormatMermaid.String(),
+		formatters.OutputFormatText.String(),
+		formatJSON,
+		formatSARIF,
+	}, ", ")
+}
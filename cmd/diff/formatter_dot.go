@@ -6,18 +6,30 @@ import (
 	"strings"
 )
 
-func (dotDiffFormatter) Format(delta graphDelta) (string, error) {
-	return renderDeltaDOT(delta), nil
+func (dotDiffFormatter) Format(delta graphDelta, opts diffRenderOptions) (string, error) {
+	switch {
+	case opts.layout == layoutSideBySide:
+		return renderSideBySideDOT(delta, newDeltaView(delta, opts.impact), opts.comparison), nil
+	case opts.impact:
+		return renderImpactDOT(delta, newDeltaView(delta, true), opts.comparison), nil
+	default:
+		return renderDeltaDOT(delta, opts.comparison), nil
+	}
 }
 
-func renderDeltaDOT(delta graphDelta) string {
+func renderDeltaDOT(delta graphDelta, comparison comparisonLabel) string {
 	var b strings.Builder
-	b.WriteString("digraph diff {\n")
-	b.WriteString("  rankdir=LR;\n")
-	b.WriteString("  node [shape=box];\n")
+	writeDOTHeader(&b, "LR", delta, comparison)
 
+	moved := movedTargets(delta.nodesMoved)
+	for _, move := range delta.nodesMoved {
+		b.WriteString(fmt.Sprintf("  %q [label=%q, style=\"filled,dashed\", fillcolor=\"#dbe9f6\", color=\"#1f77b4\"];\n", move.to, moveLabel(move)))
+	}
 	changedNodes := sortedChangedNodes(delta.changedNodes)
 	for _, n := range changedNodes {
+		if _, ok := moved[n]; ok {
+			continue
+		}
 		b.WriteString(fmt.Sprintf("  %q [label=%q, style=filled, fillcolor=\"#d9f2d9\", color=\"#2e8b57\"];\n", n, filepath.Base(n)))
 	}
 	for _, n := range delta.nodesAdded {
@@ -28,6 +40,10 @@ func renderDeltaDOT(delta graphDelta) string {
 	}
 
 	for _, e := range delta.edgesAdded {
+		if isCycleBreak(delta.cycleBreaks, e) {
+			b.WriteString(fmt.Sprintf("  %q -> %q [color=\"#2e8b57\", penwidth=2];\n", e.from, e.to))
+			continue
+		}
 		b.WriteString(fmt.Sprintf("  %q -> %q [color=\"#2e8b57\"];\n", e.from, e.to))
 	}
 	for _, e := range delta.edgesRemoved {
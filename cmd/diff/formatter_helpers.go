@@ -1,6 +1,13 @@
 package diff
 
-import "sort"
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
 
 func sortedChangedNodes(changed map[string]struct{}) []string {
 	if len(changed) == 0 {
@@ -13,3 +20,43 @@ func sortedChangedNodes(changed map[string]struct{}) []string {
 	sort.Strings(nodes)
 	return nodes
 }
+
+// movedTargets indexes the moved nodes by their target path.
+func movedTargets(moves []nodeMove) map[string]nodeMove {
+	targets := make(map[string]nodeMove, len(moves))
+	for _, move := range moves {
+		targets[move.to] = move
+	}
+	return targets
+}
+
+// moveLabel describes a moved node as "src/b.ts → lib/b.ts", dropping the directories both paths share.
+func moveLabel(move nodeMove) string {
+	fromParts := strings.Split(filepath.ToSlash(move.from), "/")
+	toParts := strings.Split(filepath.ToSlash(move.to), "/")
+	common := 0
+	for common < len(fromParts)-1 && common < len(toParts)-1 && fromParts[common] == toParts[common] {
+		common++
+	}
+	return fmt.Sprintf("%s → %s", strings.Join(fromParts[common:], "/"), strings.Join(toParts[common:], "/"))
+}
+
+// cycleBreakSummaries describes the suggested cycle breaks as "a.go -> b.go (2 cycles)" using base names.
+func cycleBreakSummaries(breaks []depgraph.CycleBreak) []string {
+	summaries := make([]string, 0, len(breaks))
+	for _, cycleBreak := range breaks {
+		summaries = append(summaries, fmt.Sprintf("%s -> %s (%s)",
+			filepath.Base(cycleBreak.Edge.From), filepath.Base(cycleBreak.Edge.To), depgraph.CycleCountLabel(cycleBreak.Cycles)))
+	}
+	return summaries
+}
+
+// isCycleBreak reports whether the edge is one of the suggested cycle breaks.
+func isCycleBreak(breaks []depgraph.CycleBreak, e graphEdge) bool {
+	for _, cycleBreak := range breaks {
+		if cycleBreak.Edge.From == e.from && cycleBreak.Edge.To == e.to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package diff
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// jsonSchemaVersion versions the JSON output. Paths are relative to the repository root, as in
+// SARIF, and only files outside it keep their absolute path. Adding fields keeps the version; renaming or
+// removing fields, or changing their meaning, bumps it.
+const jsonSchemaVersion = 1
+
+type jsonDeltaOutput struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Comparison    *jsonComparison   `json:"comparison,omitempty"`
+	NodesAdded    []string          `json:"nodesAdded"`
+	NodesRemoved  []string          `json:"nodesRemoved"`
+	NodesMoved    []jsonNodeMove    `json:"nodesMoved"`
+	ChangedNodes  []jsonChangedNode `json:"changedNodes"`
+	EdgesAdded    []jsonDeltaEdge   `json:"edgesAdded"`
+	EdgesRemoved  []jsonDeltaEdge   `json:"edgesRemoved"`
+	Findings      []jsonFinding     `json:"findings"`
+	CycleChanges  []jsonCycleChange `json:"cycleChanges"`
+	CycleBreaks   []jsonCycleBreak  `json:"cycleBreaks"`
+}
+
+type jsonComparison struct {
+	Label  string       `json:"label"`
+	Base   jsonSnapshot `json:"base"`
+	Target jsonSnapshot `json:"target"`
+}
+
+type jsonSnapshot struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit,omitempty"`
+}
+
+type jsonNodeMove struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type jsonChangedNode struct {
+	Path  string         `json:"path"`
+	Stats *jsonNodeStats `json:"stats,omitempty"`
+}
+
+type jsonNodeStats struct {
+	Additions int  `json:"additions"`
+	Deletions int  `json:"deletions"`
+	IsNew     bool `json:"isNew"`
+}
+
+type jsonDeltaEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type jsonFinding struct {
+	RuleID   string   `json:"ruleId"`
+	Severity string   `json:"severity"`
+	Message  string   `json:"message"`
+	Paths    []string `json:"paths"`
+}
+
+type jsonCycleChange struct {
+	Kind        string   `json:"kind"`
+	Path        []string `json:"path"`
+	BaseNodes   []string `json:"baseNodes"`
+	TargetNodes []string `json:"targetNodes"`
+}
+
+type jsonCycleBreak struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Cycles int    `json:"cycles"`
+}
+
+func (jsonDiffFormatter) Format(delta graphDelta, opts diffRenderOptions) (string, error) {
+	if !opts.isDefault() {
+		return "", errLayoutUnsupported(formatJSON)
+	}
+	output := jsonDeltaOutput{
+		SchemaVersion: jsonSchemaVersion,
+		NodesAdded:    repoRelativePaths(delta.nodesAdded, opts.repoRoot),
+		NodesRemoved:  repoRelativePaths(delta.nodesRemoved, opts.repoRoot),
+		NodesMoved:    []jsonNodeMove{},
+		ChangedNodes:  []jsonChangedNode{},
+		EdgesAdded:    jsonDeltaEdges(delta.edgesAdded, opts.repoRoot),
+		EdgesRemoved:  jsonDeltaEdges(delta.edgesRemoved, opts.repoRoot),
+		Findings:      []jsonFinding{},
+		CycleChanges:  []jsonCycleChange{},
+		CycleBreaks:   []jsonCycleBreak{},
+	}
+	if label := opts.comparison.String(); label != "" {
+		output.Comparison = &jsonComparison{
+			Label:  label,
+			Base:   jsonSnapshot{Name: opts.comparison.base.name, Commit: opts.comparison.base.commit},
+			Target: jsonSnapshot{Name: opts.comparison.target.name, Commit: opts.comparison.target.commit},
+		}
+	}
+	for _, move := range delta.nodesMoved {
+		output.NodesMoved = append(output.NodesMoved, jsonNodeMove{From: jsonPath(move.from, opts.repoRoot), To: jsonPath(move.to, opts.repoRoot)})
+	}
+	for _, path := range sortedChangedNodes(delta.changedNodes) {
+		node := jsonChangedNode{Path: jsonPath(path, opts.repoRoot)}
+		if stats, ok := delta.changedStats[path]; ok {
+			node.Stats = &jsonNodeStats{
+				Additions: stats.Additions,
+				Deletions: stats.Deletions,
+				IsNew:     stats.IsNew,
+			}
+		}
+		output.ChangedNodes = append(output.ChangedNodes, node)
+	}
+	for _, f := range delta.findings {
+		output.Findings = append(output.Findings, jsonFinding{
+			RuleID:   f.ruleID,
+			Severity: string(f.severity),
+			Message:  f.message,
+			Paths:    repoRelativePaths(f.paths, opts.repoRoot),
+		})
+	}
+	for _, change := range delta.cycleChanges {
+		output.CycleChanges = append(output.CycleChanges, jsonCycleChange{
+			Kind:        string(change.kind),
+			Path:        repoRelativePaths(change.path, opts.repoRoot),
+			BaseNodes:   repoRelativePaths(change.baseNodes, opts.repoRoot),
+			TargetNodes: repoRelativePaths(change.targetNodes, opts.repoRoot),
+		})
+	}
+	for _, cycleBreak := range delta.cycleBreaks {
+		output.CycleBreaks = append(output.CycleBreaks, jsonCycleBreak{
+			From:   jsonPath(cycleBreak.Edge.From, opts.repoRoot),
+			To:     jsonPath(cycleBreak.Edge.To, opts.repoRoot),
+			Cycles: cycleBreak.Cycles,
+		})
+	}
+
+	return marshalIndentJSON(output)
+}
+
+// marshalIndentJSON writes v without escaping the "->" of cycle paths as "\u003e".
+func marshalIndentJSON(v any) (string, error) {
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+func jsonDeltaEdges(edges []graphEdge, repoRoot string) []jsonDeltaEdge {
+	result := make([]jsonDeltaEdge, 0, len(edges))
+	for _, e := range edges {
+		result = append(result, jsonDeltaEdge{From: jsonPath(e.from, repoRoot), To: jsonPath(e.to, repoRoot)})
+	}
+	return result
+}
+
+func jsonPath(path, repoRoot string) string {
+	rel, _ := repoRelativePath(path, repoRoot)
+	return rel
+}
@@ -0,0 +1,89 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// dotNodeAttrs and dotEdgeAttrs style view elements by class, matching the merged rendering.
+var dotNodeAttrs = map[string]string{
+	classAdded:     `style=filled, fillcolor="#d9f2d9", color="#2e8b57"`,
+	classRemoved:   `style=filled, fillcolor="#f8d7da", color="#b22222"`,
+	classMoved:     `style="filled,dashed", fillcolor="#dbe9f6", color="#1f77b4"`,
+	classUnchanged: `style=filled, fillcolor="#f5f6f8", color="#c3c7cf", fontcolor="#667085"`,
+	classAbsent:    `style=invis`,
+}
+
+var dotEdgeAttrs = map[string]string{
+	classAdded:     `color="#2e8b57"`,
+	classRemoved:   `color="#b22222", style=dashed`,
+	classUnchanged: `color="#c3c7cf"`,
+	classAbsent:    `style=invis`,
+}
+
+// renderImpactDOT draws the merged delta with the unchanged neighbors of every change as context.
+func renderImpactDOT(delta graphDelta, view deltaView, comparison comparisonLabel) string {
+	var b strings.Builder
+	writeDOTHeader(&b, "LR", delta, comparison)
+	writeDOTView(&b, view, sideMerged, "", "  ")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// renderSideBySideDOT draws the base and target snapshots as two clusters. Top-to-bottom ranks
+// place the clusters left and right of each other.
+func renderSideBySideDOT(delta graphDelta, view deltaView, comparison comparisonLabel) string {
+	var b strings.Builder
+	writeDOTHeader(&b, "TB", delta, comparison)
+	for _, side := range []struct {
+		name  string
+		side  snapshotSide
+		label snapshotLabel
+	}{{"base", sideBase, comparison.base}, {"target", sideTarget, comparison.target}} {
+		b.WriteString(fmt.Sprintf("  subgraph \"cluster_%s\" {\n", side.name))
+		b.WriteString(fmt.Sprintf("    label=%q;\n", sideTitle(side.name, side.label)))
+		writeDOTView(&b, view, side.side, side.name+":", "    ")
+		b.WriteString("  }\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// renderSnapshotDOT draws one side of the view as its own graph, laid out exactly like the other side.
+func renderSnapshotDOT(view deltaView, side snapshotSide) string {
+	var b strings.Builder
+	b.WriteString("digraph snapshot {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	writeDOTView(&b, view, side, "", "  ")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// writeDOTHeader opens the diff graph, titled with the comparison when there is one.
+func writeDOTHeader(b *strings.Builder, rankdir string, delta graphDelta, comparison comparisonLabel) {
+	b.WriteString("digraph diff {\n")
+	b.WriteString(fmt.Sprintf("  rankdir=%s;\n", rankdir))
+	b.WriteString("  node [shape=box];\n")
+	if label := comparison.String(); label != "" {
+		b.WriteString(fmt.Sprintf("  label=%q;\n", label))
+		b.WriteString("  labelloc=t;\n")
+		b.WriteString("  labeljust=l;\n")
+	}
+	if summaries := cycleBreakSummaries(delta.cycleBreaks); len(summaries) > 0 {
+		b.WriteString("  // Suggested cycle breaks:\n")
+		for _, summary := range summaries {
+			b.WriteString(fmt.Sprintf("  // %s\n", summary))
+		}
+	}
+}
+
+// writeDOTView writes every node and edge of the view, prefixing node IDs so two sides can share a graph.
+func writeDOTView(b *strings.Builder, view deltaView, side snapshotSide, idPrefix, indent string) {
+	for _, n := range view.nodes {
+		b.WriteString(fmt.Sprintf("%s%q [label=%q, %s];\n", indent, idPrefix+n, view.label(n), dotNodeAttrs[view.nodeClass(n, side)]))
+	}
+	for _, e := range view.edges {
+		b.WriteString(fmt.Sprintf("%s%q -> %q [%s];\n", indent, idPrefix+e.from, idPrefix+e.to, dotEdgeAttrs[view.edgeClass(e, side)]))
+	}
+}
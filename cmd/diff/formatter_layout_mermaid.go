@@ -0,0 +1,113 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mermaidClassDefs style view elements by class, matching the merged rendering.
+var mermaidClassDefs = []struct {
+	class string
+	style string
+}{
+	{classAdded, "fill:#d9f2d9,stroke:#2e8b57,color:#000000"},
+	{classRemoved, "fill:#f8d7da,stroke:#b22222,color:#000000"},
+	{classMoved, "fill:#dbe9f6,stroke:#1f77b4,color:#000000,stroke-dasharray: 5 3"},
+	{classUnchanged, "fill:#f5f6f8,stroke:#c3c7cf,color:#667085,stroke-dasharray: 5 3"},
+	{classAbsent, "fill:none,stroke:none,color:transparent"},
+}
+
+var mermaidLinkStyles = map[string]string{
+	classAdded:     "stroke:#2e8b57",
+	classRemoved:   "stroke:#b22222",
+	classUnchanged: "stroke:#c3c7cf",
+}
+
+// mermaidViewWriter numbers links across subgraphs, since linkStyle indexes every link of the chart.
+type mermaidViewWriter struct {
+	b          strings.Builder
+	links      int
+	linkStyles []string
+	classes    map[string][]string
+}
+
+func newMermaidViewWriter(comparison comparisonLabel) *mermaidViewWriter {
+	w := &mermaidViewWriter{classes: make(map[string][]string)}
+	writeMermaidHeader(&w.b, comparison)
+	return w
+}
+
+// renderImpactMermaid draws the merged delta with the unchanged neighbors of every change as context.
+func renderImpactMermaid(delta graphDelta, view deltaView, comparison comparisonLabel) string {
+	w := newMermaidViewWriter(comparison)
+	w.writeCycleBreaks(delta)
+	w.writeView(view, sideMerged, "n", "    ")
+	return w.finish()
+}
+
+// renderSideBySideMermaid draws the base and target snapshots as two subgraphs with matching nodes.
+func renderSideBySideMermaid(delta graphDelta, view deltaView, comparison comparisonLabel) string {
+	w := newMermaidViewWriter(comparison)
+	w.writeCycleBreaks(delta)
+	for _, side := range []struct {
+		name  string
+		side  snapshotSide
+		id    string
+		label snapshotLabel
+	}{{"base", sideBase, "b", comparison.base}, {"target", sideTarget, "t", comparison.target}} {
+		w.b.WriteString(fmt.Sprintf("    subgraph %s[\"%s\"]\n", side.name, sideTitle(side.name, side.label)))
+		w.b.WriteString("        direction LR\n")
+		w.writeView(view, side.side, side.id, "        ")
+		w.b.WriteString("    end\n")
+	}
+	return w.finish()
+}
+
+func (w *mermaidViewWriter) writeCycleBreaks(delta graphDelta) {
+	for _, summary := range cycleBreakSummaries(delta.cycleBreaks) {
+		w.b.WriteString(fmt.Sprintf("%%%% break %s\n", summary))
+	}
+}
+
+// writeView writes every node and edge of the view. Absent edges become invisible links so both
+// sides keep the same shape.
+func (w *mermaidViewWriter) writeView(view deltaView, side snapshotSide, idPrefix, indent string) {
+	nodeIDs := make(map[string]string, len(view.nodes))
+	for i, n := range view.nodes {
+		id := fmt.Sprintf("%s%d", idPrefix, i)
+		nodeIDs[n] = id
+		w.b.WriteString(fmt.Sprintf("%s%s[\"%s\"]\n", indent, id, view.label(n)))
+		class := view.nodeClass(n, side)
+		w.classes[class] = append(w.classes[class], id)
+	}
+	for _, e := range view.edges {
+		class := view.edgeClass(e, side)
+		arrow := "-->"
+		switch class {
+		case classAbsent:
+			arrow = "~~~"
+		case classRemoved:
+			arrow = "-.->"
+		}
+		w.b.WriteString(fmt.Sprintf("%s%s %s %s\n", indent, nodeIDs[e.from], arrow, nodeIDs[e.to]))
+		if style, ok := mermaidLinkStyles[class]; ok {
+			w.linkStyles = append(w.linkStyles, fmt.Sprintf("    linkStyle %d %s\n", w.links, style))
+		}
+		w.links++
+	}
+}
+
+func (w *mermaidViewWriter) finish() string {
+	for _, def := range mermaidClassDefs {
+		ids := w.classes[def.class]
+		if len(ids) == 0 {
+			continue
+		}
+		w.b.WriteString(fmt.Sprintf("    classDef %s %s\n", def.class, def.style))
+		w.b.WriteString(fmt.Sprintf("    class %s %s\n", strings.Join(ids, ","), def.class))
+	}
+	for _, style := range w.linkStyles {
+		w.b.WriteString(style)
+	}
+	return w.b.String()
+}
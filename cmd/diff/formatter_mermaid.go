@@ -7,23 +7,41 @@ import (
 	"strings"
 )
 
-func (mermaidDiffFormatter) Format(delta graphDelta) (string, error) {
-	return renderDeltaMermaid(delta), nil
+func (mermaidDiffFormatter) Format(delta graphDelta, opts diffRenderOptions) (string, error) {
+	switch {
+	case opts.layout == layoutSideBySide:
+		return renderSideBySideMermaid(delta, newDeltaView(delta, opts.impact), opts.comparison), nil
+	case opts.impact:
+		return renderImpactMermaid(delta, newDeltaView(delta, true), opts.comparison), nil
+	default:
+		return renderDeltaMermaid(delta, opts.comparison), nil
+	}
 }
 
-func renderDeltaMermaid(delta graphDelta) string {
+func renderDeltaMermaid(delta graphDelta, comparison comparisonLabel) string {
 	var b strings.Builder
-	b.WriteString("flowchart LR\n")
+	writeMermaidHeader(&b, comparison)
+	for _, summary := range cycleBreakSummaries(delta.cycleBreaks) {
+		b.WriteString(fmt.Sprintf("%%%% break %s\n", summary))
+	}
 
+	moved := movedTargets(delta.nodesMoved)
 	nodeIDs := make(map[string]string)
 	nodes := sortedChangedNodes(delta.changedNodes)
 	nodes = append(nodes, delta.nodesAdded...)
 	nodes = append(nodes, delta.nodesRemoved...)
+	for _, move := range delta.nodesMoved {
+		nodes = append(nodes, move.to)
+	}
 	nodes = dedupeSortedStrings(nodes)
 	for i, n := range nodes {
 		id := fmt.Sprintf("n%d", i)
 		nodeIDs[n] = id
-		b.WriteString(fmt.Sprintf("    %s[\"%s\"]\n", id, filepath.Base(n)))
+		label := filepath.Base(n)
+		if move, ok := moved[n]; ok {
+			label = moveLabel(move)
+		}
+		b.WriteString(fmt.Sprintf("    %s[\"%s\"]\n", id, label))
 	}
 
 	for _, e := range delta.edgesAdded {
@@ -60,6 +78,9 @@ func renderDeltaMermaid(delta graphDelta) string {
 	if len(delta.changedNodes) > 0 {
 		addedClasses := make([]string, 0, len(delta.changedNodes))
 		for _, n := range sortedChangedNodes(delta.changedNodes) {
+			if _, ok := moved[n]; ok {
+				continue
+			}
 			if id := nodeIDs[n]; id != "" {
 				addedClasses = append(addedClasses, id)
 			}
@@ -82,11 +103,23 @@ func renderDeltaMermaid(delta graphDelta) string {
 		}
 	}
 
+	if len(delta.nodesMoved) > 0 {
+		movedClasses := make([]string, 0, len(delta.nodesMoved))
+		for _, move := range delta.nodesMoved {
+			movedClasses = append(movedClasses, nodeIDs[move.to])
+		}
+		b.WriteString("    classDef moved fill:#dbe9f6,stroke:#1f77b4,color:#000000,stroke-dasharray: 5 3\n")
+		b.WriteString(fmt.Sprintf("    class %s moved\n", strings.Join(movedClasses, ",")))
+	}
+
 	unchangedClasses := make([]string, 0, len(nodeIDs))
 	for path, id := range nodeIDs {
 		if _, changed := delta.changedNodes[path]; changed {
 			continue
 		}
+		if _, ok := moved[path]; ok {
+			continue
+		}
 		unchangedClasses = append(unchangedClasses, id)
 	}
 	sort.Strings(unchangedClasses)
@@ -98,6 +131,16 @@ func renderDeltaMermaid(delta graphDelta) string {
 	return b.String()
 }
 
+// writeMermaidHeader opens the flowchart, titled with the comparison when there is one.
+func writeMermaidHeader(b *strings.Builder, comparison comparisonLabel) {
+	if label := comparison.String(); label != "" {
+		b.WriteString("---\n")
+		b.WriteString(fmt.Sprintf("title: %s\n", label))
+		b.WriteString("---\n")
+	}
+	b.WriteString("flowchart LR\n")
+}
+
 func dedupeSortedStrings(values []string) []string {
 	if len(values) == 0 {
 		return values
@@ -0,0 +1,106 @@
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+)
+
+// overlayPageTitle heads overlay pages rendered without a comparison label.
+const overlayPageTitle = "Clarity Diff"
+
+// overlayPage stacks the base and target drawings and fades between them with a slider. Both SVGs
+// share one layout, so a node stays in place while the slider moves.
+var overlayPage = template.Must(template.New("overlay").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+  body { font-family: sans-serif; margin: 16px; }
+  .controls { display: flex; gap: 8px; align-items: center; margin-bottom: 12px; }
+  .stage { position: relative; }
+  .snapshot { position: absolute; top: 0; left: 0; }
+  .snapshot.base { position: relative; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="controls">
+  <span>{{.BaseTitle}}</span>
+  <input id="snapshot-slider" type="range" min="0" max="100" value="100" aria-label="Snapshot">
+  <span>{{.TargetTitle}}</span>
+</div>
+<div class="stage">
+  <div class="snapshot base" id="snapshot-base">{{.BaseSVG}}</div>
+  <div class="snapshot target" id="snapshot-target">{{.TargetSVG}}</div>
+</div>
+<script>
+  const slider = document.getElementById("snapshot-slider");
+  const base = document.getElementById("snapshot-base");
+  const target = document.getElementById("snapshot-target");
+  function showSnapshot() {
+    const weight = slider.value / 100;
+    target.style.opacity = weight;
+    base.style.opacity = 1 - weight;
+  }
+  slider.addEventListener("input", showSnapshot);
+  showSnapshot();
+</script>
+</body>
+</html>
+`))
+
+type overlayPageData struct {
+	Title       string
+	BaseTitle   string
+	TargetTitle string
+	BaseSVG     template.HTML
+	TargetSVG   template.HTML
+}
+
+func (overlayDiffFormatter) Format(delta graphDelta, opts diffRenderOptions) (string, error) {
+	view := newDeltaView(delta, opts.impact)
+
+	baseSVG, err := renderOverlaySide(view, sideBase)
+	if err != nil {
+		return "", err
+	}
+	targetSVG, err := renderOverlaySide(view, sideTarget)
+	if err != nil {
+		return "", err
+	}
+
+	title := opts.comparison.String()
+	if title == "" {
+		title = overlayPageTitle
+	}
+	var page bytes.Buffer
+	err = overlayPage.Execute(&page, overlayPageData{
+		Title:       title,
+		BaseTitle:   sideTitle("base", opts.comparison.base),
+		TargetTitle: sideTitle("target", opts.comparison.target),
+		BaseSVG:     template.HTML(baseSVG),
+		TargetSVG:   template.HTML(targetSVG),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render overlay page: %w", err)
+	}
+	return page.String(), nil
+}
+
+// renderOverlaySide lays out one side with the embedded Graphviz build and drops the XML prolog so
+// the SVG can be inlined. Graphviz escapes node labels, so the markup is safe to embed.
+func renderOverlaySide(view deltaView, side snapshotSide) (string, error) {
+	svg, err := formatters.RenderDOTToSVG(renderSnapshotDOT(view, side))
+	if err != nil {
+		return "", err
+	}
+	if idx := strings.Index(svg, "<svg"); idx >= 0 {
+		svg = svg[idx:]
+	}
+	return svg, nil
+}
@@ -0,0 +1,126 @@
+package diff
+
+import (
+	"net/url"
+	"path/filepath"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	// sarifSourceRoot is the uriBaseId of repository-relative locations. Code-scanning uploads
+	// resolve it to the checkout.
+	sarifSourceRoot = "%SRCROOT%"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool           `json:"tool"`
+	Results    []sarifResult       `json:"results"`
+	Properties *sarifRunProperties `json:"properties,omitempty"`
+}
+
+type sarifRunProperties struct {
+	Comparison string `json:"comparison"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+// Format writes the findings of the delta as a SARIF 2.1.0 log that code-scanning tools can
+// annotate. The structural changes themselves are left to the json format.
+func (sarifDiffFormatter) Format(delta graphDelta, opts diffRenderOptions) (string, error) {
+	if !opts.isDefault() {
+		return "", errLayoutUnsupported(formatSARIF)
+	}
+
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "clarity",
+			InformationURI: "https://github.com/LegacyCodeHQ/clarity",
+			Rules:          make([]sarifRule, 0, len(findingRules)),
+		}},
+		Results: make([]sarifResult, 0, len(delta.findings)),
+	}
+	if label := opts.comparison.String(); label != "" {
+		run.Properties = &sarifRunProperties{Comparison: label}
+	}
+	for _, rule := range findingRules {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:                   rule.id,
+			ShortDescription:     sarifMessage{Text: rule.description},
+			DefaultConfiguration: sarifConfiguration{Level: string(rule.severity)},
+		})
+	}
+	for _, f := range delta.findings {
+		result := sarifResult{
+			RuleID:    f.ruleID,
+			Level:     string(f.severity),
+			Message:   sarifMessage{Text: f.message},
+			Locations: make([]sarifLocation, 0, len(f.paths)),
+		}
+		for _, path := range f.paths {
+			result.Locations = append(result.Locations, sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifact(path, opts.repoRoot)},
+			})
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	return marshalIndentJSON(sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}})
+}
+
+// sarifArtifact locates a file relative to the repository root, falling back to an absolute
+// file URI for paths outside it.
+func sarifArtifact(path, repoRoot string) sarifArtifactLocation {
+	if rel, ok := repoRelativePath(path, repoRoot); ok {
+		return sarifArtifactLocation{URI: (&url.URL{Path: rel}).String(), URIBaseID: sarifSourceRoot}
+	}
+	return sarifArtifactLocation{URI: (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()}
+}
@@ -1,14 +1,29 @@
 package diff
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
 
 func TestNewDiffFormatter_UnknownFormat(t *testing.T) {
-	_, err := NewDiffFormatter("json")
+	_, err := NewDiffFormatter("yaml")
 	if err == nil {
 		t.Fatal("expected unknown format error")
 	}
 }
 
+func TestNewDiffFormatter_ShowOnlyFormatIsUnsupported(t *testing.T) {
+	_, err := NewDiffFormatter("plantuml")
+	if err == nil {
+		t.Fatal("expected unsupported format error")
+	}
+	if !strings.Contains(err.Error(), "unsupported format for diff: plantuml (valid options: dot, mermaid, text, json, sarif)") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestNewDiffFormatter_DOT(t *testing.T) {
 	formatter, err := NewDiffFormatter("dot")
 	if err != nil {
@@ -28,3 +43,183 @@ func TestNewDiffFormatter_Mermaid(t *testing.T) {
 		t.Fatalf("expected mermaidDiffFormatter, got %T", formatter)
 	}
 }
+
+func TestNewDiffFormatter_Text(t *testing.T) {
+	formatter, err := NewDiffFormatter("text")
+	if err != nil {
+		t.Fatalf("NewDiffFormatter() error = %v", err)
+	}
+	if _, ok := formatter.(textDiffFormatter); !ok {
+		t.Fatalf("expected textDiffFormatter, got %T", formatter)
+	}
+}
+
+func TestNewDiffFormatter_JSON(t *testing.T) {
+	formatter, err := NewDiffFormatter("JSON")
+	if err != nil {
+		t.Fatalf("NewDiffFormatter() error = %v", err)
+	}
+	if _, ok := formatter.(jsonDiffFormatter); !ok {
+		t.Fatalf("expected jsonDiffFormatter, got %T", formatter)
+	}
+}
+
+func TestNewDiffFormatter_SARIF(t *testing.T) {
+	formatter, err := NewDiffFormatter("sarif")
+	if err != nil {
+		t.Fatalf("NewDiffFormatter() error = %v", err)
+	}
+	if _, ok := formatter.(sarifDiffFormatter); !ok {
+		t.Fatalf("expected sarifDiffFormatter, got %T", formatter)
+	}
+}
+
+func TestJSONDiffFormatter_IncludesChangedNodeStats(t *testing.T) {
+	delta := graphDelta{
+		nodesAdded:   []string{"/repo/new.go"},
+		nodesRemoved: []string{},
+		edgesAdded:   []graphEdge{{from: "/repo/new.go", to: "/repo/old.go"}},
+		changedNodes: map[string]struct{}{
+			"/repo/new.go": {},
+			"/repo/old.go": {},
+		},
+		changedStats: map[string]vcs.FileStats{
+			"/repo/new.go": {Additions: 3, IsNew: true},
+		},
+	}
+
+	out, err := jsonDiffFormatter{}.Format(delta, diffRenderOptions{repoRoot: "/repo"})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	want := `{
+  "schemaVersion": 1,
+  "nodesAdded": [
+    "new.go"
+  ],
+  "nodesRemoved": [],
+  "nodesMoved": [],
+  "changedNodes": [
+    {
+      "path": "new.go",
+      "stats": {
+        "additions": 3,
+        "deletions": 0,
+        "isNew": true
+      }
+    },
+    {
+      "path": "old.go"
+    }
+  ],
+  "edgesAdded": [
+    {
+      "from": "new.go",
+      "to": "old.go"
+    }
+  ],
+  "edgesRemoved": [],
+  "findings": [],
+  "cycleChanges": [],
+  "cycleBreaks": []
+}`
+	if out != want {
+		t.Fatalf("unexpected JSON output:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestJSONDiffFormatter_IncludesFindingsAndCycleChanges(t *testing.T) {
+	delta := graphDelta{
+		findings: []finding{{
+			ruleID:   ruleNewCycle,
+			severity: severityWarning,
+			message:  "new import cycle: a.go -> b.go -> a.go",
+			paths:    []string{"/repo/a.go", "/repo/b.go"},
+		}},
+		cycleChanges: []cycleChange{{
+			kind:        cycleAdded,
+			path:        []string{"/repo/a.go", "/repo/b.go"},
+			baseNodes:   []string{},
+			targetNodes: []string{"/repo/a.go", "/repo/b.go"},
+		}},
+	}
+
+	out, err := jsonDiffFormatter{}.Format(delta, diffRenderOptions{repoRoot: "/repo"})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	wantFragments := []string{`  "findings": [
+    {
+      "ruleId": "new-cycle",
+      "severity": "warning",
+      "message": "new import cycle: a.go -> b.go -> a.go",
+      "paths": [
+        "a.go",
+        "b.go"
+      ]
+    }
+  ],`, `  "cycleChanges": [
+    {
+      "kind": "added",
+      "path": [
+        "a.go",
+        "b.go"
+      ],
+      "baseNodes": [],
+      "targetNodes": [
+        "a.go",
+        "b.go"
+      ]
+    }
+  ],`}
+	for _, fragment := range wantFragments {
+		if !strings.Contains(out, fragment) {
+			t.Fatalf("missing %q in JSON output:\n%s", fragment, out)
+		}
+	}
+}
+
+func TestSARIFDiffFormatter_ReportsFindingsWithRepositoryRelativeLocations(t *testing.T) {
+	delta := graphDelta{
+		findings: []finding{{
+			ruleID:   ruleNewCycle,
+			severity: severityWarning,
+			message:  "new import cycle: src/a.go -> /outside/b.go -> src/a.go",
+			paths:    []string{"/repo/src/a.go", "/outside/b.go"},
+		}},
+	}
+
+	out, err := sarifDiffFormatter{}.Format(delta, diffRenderOptions{repoRoot: "/repo"})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	wantFragments := []string{
+		`"version": "2.1.0"`,
+		`"name": "clarity"`,
+		`"id": "cycle-resolved"`,
+		`"ruleId": "new-cycle"`,
+		`"level": "warning"`,
+		`"uri": "src/a.go",
+                  "uriBaseId": "%SRCROOT%"`,
+		`"text": "new import cycle: src/a.go -> /outside/b.go -> src/a.go"`,
+		`"uri": "file:///outside/b.go"`,
+	}
+	for _, fragment := range wantFragments {
+		if !strings.Contains(out, fragment) {
+			t.Fatalf("missing %q in SARIF output:\n%s", fragment, out)
+		}
+	}
+	if strings.Contains(out, `"properties"`) {
+		t.Fatalf("unlabelled comparison should not add run properties:\n%s", out)
+	}
+}
+
+func TestSARIFDiffFormatter_RejectsLayouts(t *testing.T) {
+	_, err := sarifDiffFormatter{}.Format(graphDelta{}, diffRenderOptions{impact: true})
+	if err == nil || !strings.Contains(err.Error(), "not sarif") {
+		t.Fatalf("expected layout error, got %v", err)
+	}
+}
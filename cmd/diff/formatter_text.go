@@ -0,0 +1,11 @@
+package diff
+
+import "github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+
+// Format renders the same text summary as --summary.
+func (textDiffFormatter) Format(delta graphDelta, opts diffRenderOptions) (string, error) {
+	if !opts.isDefault() {
+		return "", errLayoutUnsupported(formatters.OutputFormatText.String())
+	}
+	return renderComparisonSummary(delta, opts.comparison), nil
+}
@@ -1,15 +1,43 @@
 package diff
 
+import (
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
 type graphEdge struct {
 	from string
 	to   string
 }
 
+// nodeMove pairs the base and target path of a file that git detected as renamed or moved.
+type nodeMove struct {
+	from string
+	to   string
+}
+
 type graphDelta struct {
 	nodesAdded   []string
 	nodesRemoved []string
+	// nodesMoved lists renamed files, which are neither added nor removed. Edges of a moved file
+	// are compared under its target path, so only edges that actually changed are reported.
+	nodesMoved   []nodeMove
 	edgesAdded   []graphEdge
 	edgesRemoved []graphEdge
-	findings     []string
+	findings     []finding
 	changedNodes map[string]struct{}
+	// changedStats holds line counts for changed nodes when git can report them, which is
+	// every comparison except the narrowed working-tree selections.
+	changedStats map[string]vcs.FileStats
+	// cycleBreaks suggests edges to remove from the target graph to break the cycles
+	// that contain an added edge.
+	cycleBreaks []depgraph.CycleBreak
+	// cycleChanges lists the import cycles that were introduced, resolved or reshaped.
+	cycleChanges []cycleChange
+	// baseAdj and targetAdj hold both snapshots for the layouts that draw unchanged context.
+	// Moved nodes appear under their target path in both.
+	baseAdj   map[string][]string
+	targetAdj map[string][]string
+	// repoRoot names files relative to the repository in finding messages and cycle labels.
+	repoRoot string
 }
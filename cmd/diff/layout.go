@@ -0,0 +1,268 @@
+package diff
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// diffLayout selects how a delta is drawn.
+type diffLayout string
+
+const (
+	// layoutMerged draws one graph with added and removed styling.
+	layoutMerged diffLayout = "merged"
+	// layoutSideBySide draws the base and target snapshots next to each other.
+	layoutSideBySide diffLayout = "side-by-side"
+	// layoutOverlay writes an HTML page that fades between the snapshots with a slider.
+	layoutOverlay diffLayout = "overlay"
+)
+
+var diffLayouts = []diffLayout{layoutMerged, layoutSideBySide, layoutOverlay}
+
+// diffRenderOptions controls how formatters draw a delta.
+type diffRenderOptions struct {
+	layout diffLayout
+	// impact keeps only the changed nodes and their one-hop neighbors.
+	impact bool
+	// comparison names the compared snapshots in the output.
+	comparison comparisonLabel
+	// repoRoot lets JSON and SARIF report repository-relative paths.
+	repoRoot string
+}
+
+func parseDiffLayout(value string) (diffLayout, error) {
+	for _, layout := range diffLayouts {
+		if strings.EqualFold(value, string(layout)) {
+			return layout, nil
+		}
+	}
+	return "", fmt.Errorf("unknown layout: %s (valid options: %s)", value, supportedDiffLayouts())
+}
+
+func supportedDiffLayouts() string {
+	names := make([]string, 0, len(diffLayouts))
+	for _, layout := range diffLayouts {
+		names = append(names, string(layout))
+	}
+	return strings.Join(names, ", ")
+}
+
+// isDefault reports whether the options ask for the plain merged rendering.
+func (o diffRenderOptions) isDefault() bool {
+	return (o.layout == "" || o.layout == layoutMerged) && !o.impact
+}
+
+// nodeStatus classifies a node of the delta for styling.
+type nodeStatus int
+
+const (
+	nodeUnchanged nodeStatus = iota
+	nodeAdded
+	nodeRemoved
+	nodeMoved
+	nodeChanged
+)
+
+// deltaView is the union of both snapshots, or its impact subset. Both sides of a side-by-side or
+// overlay rendering list every node and edge of the view in the same order, hiding the ones a side
+// lacks, so Graphviz places each node at the same position on both sides.
+type deltaView struct {
+	nodes       []string
+	edges       []graphEdge
+	baseNodes   map[string]struct{}
+	targetNodes map[string]struct{}
+	baseEdges   map[graphEdge]struct{}
+	targetEdges map[graphEdge]struct{}
+	moved       map[string]nodeMove
+	status      map[string]nodeStatus
+}
+
+func newDeltaView(delta graphDelta, impact bool) deltaView {
+	view := deltaView{
+		baseNodes:   collectNodes(delta.baseAdj),
+		targetNodes: collectNodes(delta.targetAdj),
+		baseEdges:   collectEdges(delta.baseAdj),
+		targetEdges: collectEdges(delta.targetAdj),
+		moved:       movedTargets(delta.nodesMoved),
+		status:      make(map[string]nodeStatus),
+	}
+	// Deltas built by hand in tests may lack the snapshots; fall back to the changes alone.
+	for _, n := range delta.nodesAdded {
+		view.targetNodes[n] = struct{}{}
+	}
+	for _, n := range delta.nodesRemoved {
+		view.baseNodes[n] = struct{}{}
+	}
+	for _, e := range delta.edgesAdded {
+		view.targetEdges[e] = struct{}{}
+	}
+	for _, e := range delta.edgesRemoved {
+		view.baseEdges[e] = struct{}{}
+	}
+	for n := range delta.changedNodes {
+		view.targetNodes[n] = struct{}{}
+	}
+	for _, move := range delta.nodesMoved {
+		view.baseNodes[move.to] = struct{}{}
+		view.targetNodes[move.to] = struct{}{}
+	}
+
+	for n := range delta.changedNodes {
+		view.status[n] = nodeChanged
+	}
+	for _, n := range delta.nodesAdded {
+		view.status[n] = nodeAdded
+	}
+	for _, n := range delta.nodesRemoved {
+		view.status[n] = nodeRemoved
+	}
+	for _, move := range delta.nodesMoved {
+		view.status[move.to] = nodeMoved
+	}
+
+	included := make(map[string]struct{})
+	for n := range view.baseNodes {
+		included[n] = struct{}{}
+	}
+	for n := range view.targetNodes {
+		included[n] = struct{}{}
+	}
+	edges := make(map[graphEdge]struct{}, len(view.baseEdges)+len(view.targetEdges))
+	for e := range view.baseEdges {
+		edges[e] = struct{}{}
+	}
+	for e := range view.targetEdges {
+		edges[e] = struct{}{}
+	}
+
+	if impact {
+		included = impactNodes(view.status, delta, edges)
+	}
+
+	for n := range included {
+		view.nodes = append(view.nodes, n)
+	}
+	sort.Strings(view.nodes)
+	for e := range edges {
+		_, fromIncluded := included[e.from]
+		_, toIncluded := included[e.to]
+		if fromIncluded && toIncluded {
+			view.edges = append(view.edges, e)
+		}
+	}
+	sort.Slice(view.edges, func(i, j int) bool {
+		if view.edges[i].from == view.edges[j].from {
+			return view.edges[i].to < view.edges[j].to
+		}
+		return view.edges[i].from < view.edges[j].from
+	})
+
+	return view
+}
+
+// impactNodes returns the nodes that changed, the endpoints of changed edges, and every node one
+// edge away from them in either snapshot.
+func impactNodes(status map[string]nodeStatus, delta graphDelta, edges map[graphEdge]struct{}) map[string]struct{} {
+	seeds := make(map[string]struct{}, len(status))
+	for n := range status {
+		seeds[n] = struct{}{}
+	}
+	for _, e := range append(append([]graphEdge{}, delta.edgesAdded...), delta.edgesRemoved...) {
+		seeds[e.from] = struct{}{}
+		seeds[e.to] = struct{}{}
+	}
+
+	included := make(map[string]struct{}, len(seeds))
+	for n := range seeds {
+		included[n] = struct{}{}
+	}
+	for e := range edges {
+		if _, ok := seeds[e.from]; ok {
+			included[e.to] = struct{}{}
+		}
+		if _, ok := seeds[e.to]; ok {
+			included[e.from] = struct{}{}
+		}
+	}
+	return included
+}
+
+// label returns the display name of a node, which is the same on both sides.
+func (v deltaView) label(n string) string {
+	if move, ok := v.moved[n]; ok {
+		return moveLabel(move)
+	}
+	return filepath.Base(n)
+}
+
+func (v deltaView) edgeAdded(e graphEdge) bool {
+	_, inBase := v.baseEdges[e]
+	_, inTarget := v.targetEdges[e]
+	return inTarget && !inBase
+}
+
+func (v deltaView) edgeRemoved(e graphEdge) bool {
+	_, inBase := v.baseEdges[e]
+	_, inTarget := v.targetEdges[e]
+	return inBase && !inTarget
+}
+
+// snapshotSide selects what one drawing of a view shows: both snapshots merged, or one of them.
+type snapshotSide int
+
+const (
+	sideMerged snapshotSide = iota
+	sideBase
+	sideTarget
+)
+
+// Node and edge classes shared by the DOT attributes and the Mermaid classDefs.
+const (
+	classAdded     = "added"
+	classRemoved   = "removed"
+	classMoved     = "moved"
+	classUnchanged = "unchanged"
+	// classAbsent hides an element the side lacks while keeping its place in the layout.
+	classAbsent = "absent"
+)
+
+func (v deltaView) nodeClass(n string, side snapshotSide) string {
+	if _, ok := v.baseNodes[n]; !ok && side == sideBase {
+		return classAbsent
+	}
+	if _, ok := v.targetNodes[n]; !ok && side == sideTarget {
+		return classAbsent
+	}
+	switch v.status[n] {
+	case nodeMoved:
+		return classMoved
+	case nodeRemoved:
+		return classRemoved
+	case nodeAdded, nodeChanged:
+		if side == sideBase {
+			return classUnchanged
+		}
+		return classAdded
+	default:
+		return classUnchanged
+	}
+}
+
+func (v deltaView) edgeClass(e graphEdge, side snapshotSide) string {
+	if _, ok := v.baseEdges[e]; !ok && side == sideBase {
+		return classAbsent
+	}
+	if _, ok := v.targetEdges[e]; !ok && side == sideTarget {
+		return classAbsent
+	}
+	switch {
+	case v.edgeAdded(e):
+		return classAdded
+	case v.edgeRemoved(e):
+		return classRemoved
+	default:
+		return classUnchanged
+	}
+}
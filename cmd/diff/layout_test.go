@@ -0,0 +1,158 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+func layoutTestDelta(t *testing.T) graphDelta {
+	t.Helper()
+
+	base := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/b.go"},
+		"/repo/b.go": {"/repo/c.go"},
+		"/repo/c.go": {},
+		"/repo/y.go": {"/repo/z.go"},
+		"/repo/z.go": {},
+	})
+	target := depgraph.MustDependencyGraph(map[string][]string{
+		"/repo/a.go": {"/repo/c.go"},
+		"/repo/b.go": {"/repo/c.go"},
+		"/repo/c.go": {},
+		"/repo/y.go": {"/repo/z.go"},
+		"/repo/z.go": {},
+	})
+	delta, err := buildGraphDelta(base, target, nil)
+	if err != nil {
+		t.Fatalf("buildGraphDelta() error = %v", err)
+	}
+	delta.changedNodes = map[string]struct{}{"/repo/a.go": {}}
+	return delta
+}
+
+func TestParseDiffLayout(t *testing.T) {
+	layout, err := parseDiffLayout("Side-By-Side")
+	if err != nil || layout != layoutSideBySide {
+		t.Fatalf("parseDiffLayout() = %q, %v", layout, err)
+	}
+
+	_, err = parseDiffLayout("stacked")
+	if err == nil || !strings.Contains(err.Error(), "unknown layout: stacked (valid options: merged, side-by-side, overlay)") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewDeltaView_ImpactKeepsOneHopNeighbors(t *testing.T) {
+	delta := layoutTestDelta(t)
+
+	full := newDeltaView(delta, false)
+	if got := strings.Join(full.nodes, ","); got != "/repo/a.go,/repo/b.go,/repo/c.go,/repo/y.go,/repo/z.go" {
+		t.Fatalf("unexpected full view nodes: %s", got)
+	}
+
+	impact := newDeltaView(delta, true)
+	if got := strings.Join(impact.nodes, ","); got != "/repo/a.go,/repo/b.go,/repo/c.go" {
+		t.Fatalf("unexpected impact view nodes: %s", got)
+	}
+	if len(impact.edges) != 3 {
+		t.Fatalf("expected the removed, added and unchanged edges among a, b and c, got %+v", impact.edges)
+	}
+}
+
+func TestRenderSideBySideDOT_HidesElementsMissingFromASide(t *testing.T) {
+	delta := layoutTestDelta(t)
+
+	out, err := dotDiffFormatter{}.Format(delta, diffRenderOptions{layout: layoutSideBySide, impact: true})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`subgraph "cluster_base" {`,
+		`subgraph "cluster_target" {`,
+		`"base:/repo/a.go" -> "base:/repo/b.go" [color="#b22222", style=dashed];`,
+		`"base:/repo/a.go" -> "base:/repo/c.go" [style=invis];`,
+		`"target:/repo/a.go" -> "target:/repo/b.go" [style=invis];`,
+		`"target:/repo/a.go" -> "target:/repo/c.go" [color="#2e8b57"];`,
+		`"target:/repo/a.go" [label="a.go", style=filled, fillcolor="#d9f2d9", color="#2e8b57"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "y.go") {
+		t.Fatalf("impact layout should drop unrelated nodes:\n%s", out)
+	}
+}
+
+func TestRenderSideBySideMermaid_UsesInvisibleLinksForMissingEdges(t *testing.T) {
+	delta := layoutTestDelta(t)
+
+	out, err := mermaidDiffFormatter{}.Format(delta, diffRenderOptions{layout: layoutSideBySide, impact: true})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"    subgraph base[\"base\"]\n",
+		"        b0 -.-> b1\n",
+		"        b0 ~~~ b2\n",
+		"        t0 ~~~ t1\n",
+		"        t0 --> t2\n",
+		"    class t0 added\n",
+		"    linkStyle 4 stroke:#2e8b57\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderImpactDOT_StylesUnchangedContext(t *testing.T) {
+	delta := layoutTestDelta(t)
+
+	out, err := dotDiffFormatter{}.Format(delta, diffRenderOptions{impact: true})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	if !strings.Contains(out, `"/repo/b.go" -> "/repo/c.go" [color="#c3c7cf"];`) {
+		t.Fatalf("expected unchanged context edge in output:\n%s", out)
+	}
+	if strings.Contains(out, "y.go") {
+		t.Fatalf("impact layout should drop unrelated nodes:\n%s", out)
+	}
+}
+
+func TestOverlayDiffFormatter_EmbedsBothSnapshots(t *testing.T) {
+	delta := layoutTestDelta(t)
+
+	out, err := overlayDiffFormatter{}.Format(delta, diffRenderOptions{layout: layoutOverlay})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	if !strings.HasPrefix(out, "<!DOCTYPE html>") {
+		t.Fatalf("expected an HTML page, got:\n%s", out)
+	}
+	if strings.Count(out, "<svg") != 2 {
+		t.Fatalf("expected base and target SVGs, got:\n%s", out)
+	}
+	if strings.Contains(out, "<?xml") {
+		t.Fatalf("expected the XML prolog to be dropped:\n%s", out)
+	}
+	if !strings.Contains(out, `id="snapshot-slider"`) {
+		t.Fatalf("expected the snapshot slider, got:\n%s", out)
+	}
+}
+
+func TestSummaryFormatsRejectLayouts(t *testing.T) {
+	for _, formatter := range []Formatter{textDiffFormatter{}, jsonDiffFormatter{}} {
+		_, err := formatter.Format(graphDelta{}, diffRenderOptions{layout: layoutSideBySide})
+		if err == nil || !strings.Contains(err.Error(), "--layout and --impact apply to dot and mermaid output") {
+			t.Fatalf("%T: unexpected error: %v", formatter, err)
+		}
+	}
+}
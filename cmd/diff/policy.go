@@ -0,0 +1,187 @@
+package diff
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/internal/pathglob"
+)
+
+// ExitCodePolicyViolation is the exit status of a diff that succeeded but violated a --fail-on
+// policy. Errors exit with 1, so pipelines can tell a blocked change from a broken run.
+const ExitCodePolicyViolation = 2
+
+// PolicyViolationError reports the --fail-on policies a delta violated.
+type PolicyViolationError struct {
+	Violations []string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%d --fail-on policy violation(s):\n  %s", len(e.Violations), strings.Join(e.Violations, "\n  "))
+}
+
+// ExitCode returns ExitCodePolicyViolation.
+func (e *PolicyViolationError) ExitCode() int {
+	return ExitCodePolicyViolation
+}
+
+// failPolicyKind selects what a --fail-on policy checks.
+type failPolicyKind int
+
+const (
+	// policyFinding fails when a finding with the policy's rule ID is reported, as in new-cycle.
+	policyFinding failPolicyKind = iota
+	// policyEdgeAdded fails when an added edge matches both globs, as in edge-added:src/ui/**->src/db/**.
+	policyEdgeAdded
+	// policyFanIn fails when a file gains dependents and ends up with more than the limit, as in fan-in>10.
+	policyFanIn
+)
+
+const (
+	edgeAddedPolicyPrefix = "edge-added:"
+	fanInPolicyPrefix     = "fan-in>"
+)
+
+// failPolicy is one parsed --fail-on value.
+type failPolicy struct {
+	spec     string
+	kind     failPolicyKind
+	ruleID   string
+	fromGlob string
+	toGlob   string
+	maxFanIn int
+}
+
+func parseFailPolicies(specs []string) ([]failPolicy, error) {
+	policies := make([]failPolicy, 0, len(specs))
+	for _, spec := range specs {
+		policy, err := parseFailPolicy(spec)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func parseFailPolicy(spec string) (failPolicy, error) {
+	spec = strings.TrimSpace(spec)
+	switch {
+	case strings.HasPrefix(spec, edgeAddedPolicyPrefix):
+		from, to, ok := strings.Cut(strings.TrimPrefix(spec, edgeAddedPolicyPrefix), "->")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return failPolicy{}, fmt.Errorf("invalid --fail-on policy %q: expected %s<glob>-><glob>", spec, edgeAddedPolicyPrefix)
+		}
+		for _, glob := range []string{from, to} {
+			if err := pathglob.Validate(glob); err != nil {
+				return failPolicy{}, fmt.Errorf("invalid --fail-on policy %q: %w", spec, err)
+			}
+		}
+		return failPolicy{spec: spec, kind: policyEdgeAdded, fromGlob: from, toGlob: to}, nil
+	case strings.HasPrefix(spec, fanInPolicyPrefix):
+		limit, err := strconv.Atoi(strings.TrimPrefix(spec, fanInPolicyPrefix))
+		if err != nil || limit < 0 {
+			return failPolicy{}, fmt.Errorf("invalid --fail-on policy %q: expected %sN with N a non-negative integer", spec, fanInPolicyPrefix)
+		}
+		return failPolicy{spec: spec, kind: policyFanIn, maxFanIn: limit}, nil
+	}
+
+	for _, rule := range findingRules {
+		if spec == rule.id {
+			return failPolicy{spec: spec, kind: policyFinding, ruleID: rule.id}, nil
+		}
+	}
+	return failPolicy{}, fmt.Errorf("unknown --fail-on policy: %s (valid options: %s)", spec, supportedFailPolicies())
+}
+
+func supportedFailPolicies() string {
+	options := make([]string, 0, len(findingRules)+2)
+	for _, rule := range findingRules {
+		options = append(options, rule.id)
+	}
+	options = append(options, edgeAddedPolicyPrefix+"<glob>-><glob>", fanInPolicyPrefix+"N")
+	return strings.Join(options, ", ")
+}
+
+// evaluateFailPolicies returns one line per violation, in policy order. Edge globs match paths
+// relative to the repository root, and violations name files by those paths.
+func evaluateFailPolicies(policies []failPolicy, delta graphDelta, repoRoot string) []string {
+	var violations []string
+	for _, policy := range policies {
+		switch policy.kind {
+		case policyFinding:
+			for _, f := range delta.findings {
+				if f.ruleID == policy.ruleID {
+					violations = append(violations, fmt.Sprintf("%s: %s", policy.spec, f.message))
+				}
+			}
+		case policyEdgeAdded:
+			for _, e := range delta.edgesAdded {
+				from, _ := repoRelativePath(e.from, repoRoot)
+				to, _ := repoRelativePath(e.to, repoRoot)
+				if pathglob.Match(policy.fromGlob, from) && pathglob.Match(policy.toGlob, to) {
+					violations = append(violations, fmt.Sprintf("%s: %s -> %s", policy.spec, from, to))
+				}
+			}
+		case policyFanIn:
+			fanIn := targetFanIn(delta)
+			for _, node := range nodesGainingDependents(delta) {
+				if fanIn[node] > policy.maxFanIn {
+					rel, _ := repoRelativePath(node, repoRoot)
+					violations = append(violations, fmt.Sprintf("%s: %s has %d dependents", policy.spec, rel, fanIn[node]))
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// repoRelativePath returns the slash-separated path of a node relative to the repository root.
+// Nodes outside the repository keep their full path and report false.
+func repoRelativePath(node, repoRoot string) (string, bool) {
+	if repoRoot != "" {
+		if rel, err := filepath.Rel(repoRoot, node); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel), true
+		}
+	}
+	return filepath.ToSlash(node), false
+}
+
+// repoRelativePaths applies repoRelativePath to every path.
+func repoRelativePaths(paths []string, repoRoot string) []string {
+	result := make([]string, 0, len(paths))
+	for _, path := range paths {
+		rel, _ := repoRelativePath(path, repoRoot)
+		result = append(result, rel)
+	}
+	return result
+}
+
+// targetFanIn counts the dependents of every file in the target snapshot.
+func targetFanIn(delta graphDelta) map[string]int {
+	fanIn := make(map[string]int)
+	for e := range collectEdges(delta.targetAdj) {
+		fanIn[e.to]++
+	}
+	return fanIn
+}
+
+// nodesGainingDependents returns the targets of added edges, sorted, so fan-in policies judge
+// what the change did rather than hubs that already existed.
+func nodesGainingDependents(delta graphDelta) []string {
+	seen := make(map[string]struct{})
+	var nodes []string
+	for _, e := range delta.edgesAdded {
+		if _, ok := seen[e.to]; ok {
+			continue
+		}
+		seen[e.to] = struct{}{}
+		nodes = append(nodes, e.to)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
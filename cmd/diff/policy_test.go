@@ -0,0 +1,92 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseFailPolicy(t *testing.T) {
+	tests := []struct {
+		spec string
+		want failPolicy
+	}{
+		{spec: "new-cycle", want: failPolicy{spec: "new-cycle", kind: policyFinding, ruleID: ruleNewCycle}},
+		{spec: "edge-added:src/ui/**->src/db/**", want: failPolicy{spec: "edge-added:src/ui/**->src/db/**", kind: policyEdgeAdded, fromGlob: "src/ui/**", toGlob: "src/db/**"}},
+		{spec: "fan-in>10", want: failPolicy{spec: "fan-in>10", kind: policyFanIn, maxFanIn: 10}},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFailPolicy(tt.spec)
+		if err != nil {
+			t.Fatalf("parseFailPolicy(%q) error = %v", tt.spec, err)
+		}
+		if got != tt.want {
+			t.Fatalf("parseFailPolicy(%q) = %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestParseFailPolicy_RejectsInvalidPolicies(t *testing.T) {
+	tests := map[string]string{
+		"new-cycles":              "unknown --fail-on policy: new-cycles (valid options: new-cycle, cycle-grown, cycle-resolved, edge-added:<glob>-><glob>, fan-in>N)",
+		"edge-added:src/ui/**":    "expected edge-added:<glob>-><glob>",
+		"edge-added:src/[ui->db":  `bad glob "src/[ui"`,
+		"fan-in>many":             "expected fan-in>N with N a non-negative integer",
+		"fan-in>-1":               "expected fan-in>N with N a non-negative integer",
+		"edge-added:->src/db/**":  "expected edge-added:<glob>-><glob>",
+		"edge-added:src/ui/**->":  "expected edge-added:<glob>-><glob>",
+		"edge-added:src/ui/**-db": "expected edge-added:<glob>-><glob>",
+	}
+
+	for spec, want := range tests {
+		_, err := parseFailPolicy(spec)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("parseFailPolicy(%q) error = %v, want %q", spec, err, want)
+		}
+	}
+}
+
+func TestEvaluateFailPolicies(t *testing.T) {
+	delta := graphDelta{
+		edgesAdded: []graphEdge{
+			{from: "/repo/src/ui/button.ts", to: "/repo/src/db/client.ts"},
+			{from: "/repo/src/ui/button.ts", to: "/repo/src/util.ts"},
+		},
+		findings: []finding{
+			{ruleID: ruleNewCycle, severity: severityWarning, message: "new import cycle: a.ts -> b.ts -> a.ts"},
+			{ruleID: ruleCycleResolved, severity: severityNote, message: "import cycle resolved: x.ts -> y.ts -> x.ts"},
+		},
+		targetAdj: map[string][]string{
+			"/repo/src/ui/button.ts": {"/repo/src/db/client.ts", "/repo/src/util.ts"},
+			"/repo/src/ui/form.ts":   {"/repo/src/util.ts", "/repo/src/legacy.ts"},
+			"/repo/src/ui/list.ts":   {"/repo/src/util.ts", "/repo/src/legacy.ts"},
+			"/repo/src/ui/menu.ts":   {"/repo/src/legacy.ts"},
+		},
+	}
+	policies, err := parseFailPolicies([]string{"new-cycle", "edge-added:src/ui/**->src/db/**", "edge-added:src/db/**->src/ui/**", "fan-in>2"})
+	if err != nil {
+		t.Fatalf("parseFailPolicies() error = %v", err)
+	}
+
+	got := evaluateFailPolicies(policies, delta, "/repo")
+
+	// legacy.ts has more dependents than util.ts, but gained none in this change.
+	want := []string{
+		"new-cycle: new import cycle: a.ts -> b.ts -> a.ts",
+		"edge-added:src/ui/**->src/db/**: src/ui/button.ts -> src/db/client.ts",
+		"fan-in>2: src/util.ts has 3 dependents",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected violations:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestEvaluateFailPolicies_NoViolations(t *testing.T) {
+	policies, err := parseFailPolicies([]string{"new-cycle", "fan-in>0"})
+	if err != nil {
+		t.Fatalf("parseFailPolicies() error = %v", err)
+	}
+	if got := evaluateFailPolicies(policies, graphDelta{}, "/repo"); len(got) != 0 {
+		t.Fatalf("expected no violations, got %v", got)
+	}
+}
@@ -0,0 +1,63 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs/git"
+)
+
+// checkWorkingTreeState rejects repository states that working-tree mode cannot compare, with a
+// message that says how to get out of them.
+func checkWorkingTreeState(repoPath string, selection snapshotSelection) error {
+	unborn, err := git.IsUnbornHEAD(repoPath)
+	if err != nil {
+		return err
+	}
+	if unborn {
+		return fmt.Errorf("HEAD has no commits yet: diff compares uncommitted work with HEAD, so create an initial commit first")
+	}
+
+	baseLayer, targetLayer := selection.layers()
+	if baseLayer != layerIndex && targetLayer != layerIndex {
+		return nil
+	}
+	unmerged, err := git.GetUnmergedFiles(repoPath)
+	if err != nil {
+		return err
+	}
+	if len(unmerged) > 0 {
+		return fmt.Errorf("the index has unresolved merge conflicts in %s: resolve them before comparing with --staged or --unstaged", strings.Join(unmerged, ", "))
+	}
+	return nil
+}
+
+// resolveRef resolves a --commit ref to a commit hash and explains failures caused by an unborn
+// HEAD or by history missing from a shallow clone.
+func resolveRef(repoPath, ref string) (string, error) {
+	commit, err := git.ResolveCommit(repoPath, ref)
+	if err == nil {
+		return commit, nil
+	}
+
+	if unborn, stateErr := git.IsUnbornHEAD(repoPath); stateErr == nil && unborn {
+		return "", fmt.Errorf("%w (HEAD has no commits yet)", err)
+	}
+	if shallow, stateErr := git.IsShallowRepository(repoPath); stateErr == nil && shallow {
+		return "", fmt.Errorf("%w (the repository is a shallow clone; run 'git fetch --unshallow' if the commit was not fetched)", err)
+	}
+	return "", err
+}
+
+// checkParentAvailable rejects a parentless commit whose parents were cut off by a shallow
+// clone, which would otherwise be compared with an empty graph as if it were a root commit.
+func checkParentAvailable(repoPath, ref string) error {
+	boundary, err := git.IsShallowBoundary(repoPath, ref)
+	if err != nil {
+		return err
+	}
+	if boundary {
+		return fmt.Errorf("cannot compare %s with its parent: the parent is missing from this shallow clone (run 'git fetch --deepen=1')", ref)
+	}
+	return nil
+}
@@ -10,8 +10,12 @@ import (
 	"github.com/LegacyCodeHQ/clarity/vcs/git"
 )
 
+// emptySnapshotRef names the empty base snapshot of a root commit.
+const emptySnapshotRef = "EMPTY"
+
 type snapshot struct {
 	ref         string
+	label       snapshotLabel
 	filePaths   []string
 	contentRead vcs.ContentReader
 }
@@ -27,43 +31,135 @@ func resolveSnapshots(repoPath string, comparison commitComparison) (snapshotPai
 	case diffModeCommit:
 		return resolveCommitModeSnapshots(repoPath, comparison)
 	case diffModeWorkingTree:
-		return resolveWorkingTreeSnapshots(repoPath)
+		return resolveWorkingTreeSnapshots(repoPath, comparison.selection)
 	default:
 		return snapshotPair{}, fmt.Errorf("unknown diff mode: %s", comparison.mode)
 	}
 }
 
-func resolveWorkingTreeSnapshots(repoPath string) (snapshotPair, error) {
-	baseRef := "HEAD"
-	if err := git.ValidateCommit(repoPath, baseRef); err != nil {
+// workingTreeLayer orders the snapshots that make up uncommitted work. Each layer adds one kind
+// of change on top of the previous one.
+type workingTreeLayer int
+
+const (
+	layerHead workingTreeLayer = iota
+	layerIndex
+	layerTracked
+	layerUntracked
+)
+
+// layers returns the snapshots compared for the selection: the layer below the first selected
+// change and the last selected change.
+func (s snapshotSelection) layers() (base workingTreeLayer, target workingTreeLayer) {
+	if !s.staged && !s.unstaged && !s.untracked {
+		return layerHead, layerUntracked
+	}
+
+	selected := []bool{s.staged, s.unstaged, s.untracked}
+	base, target = -1, -1
+	for i, isSelected := range selected {
+		if !isSelected {
+			continue
+		}
+		if base < 0 {
+			base = workingTreeLayer(i)
+		}
+		target = workingTreeLayer(i + 1)
+	}
+	return base, target
+}
+
+func resolveWorkingTreeSnapshots(repoPath string, selection snapshotSelection) (snapshotPair, error) {
+	if err := checkWorkingTreeState(repoPath, selection); err != nil {
+		return snapshotPair{}, err
+	}
+	if err := git.ValidateCommit(repoPath, "HEAD"); err != nil {
 		return snapshotPair{}, err
 	}
 
-	baseFiles, err := git.GetCommitTreeFiles(repoPath, baseRef)
+	baseLayer, targetLayer := selection.layers()
+	base, err := loadWorkingTreeLayer(repoPath, baseLayer)
 	if err != nil {
-		return snapshotPair{}, fmt.Errorf("failed to load base snapshot from %s: %w", baseRef, err)
+		return snapshotPair{}, fmt.Errorf("failed to load base snapshot: %w", err)
 	}
-
-	targetFiles, err := loadWorkingSnapshotFiles(repoPath)
+	target, err := loadWorkingTreeLayer(repoPath, targetLayer)
 	if err != nil {
-		return snapshotPair{}, fmt.Errorf("failed to load working snapshot: %w", err)
+		return snapshotPair{}, fmt.Errorf("failed to load target snapshot: %w", err)
 	}
 
 	return snapshotPair{
-		mode: diffModeWorkingTree,
-		base: snapshot{
-			ref:         baseRef,
-			filePaths:   baseFiles,
-			contentRead: git.GitCommitContentReader(repoPath, baseRef),
-		},
-		target: snapshot{
-			ref:         "WORKING_TREE",
-			filePaths:   targetFiles,
-			contentRead: vcs.FilesystemContentReader(),
-		},
+		mode:   diffModeWorkingTree,
+		base:   base,
+		target: target,
 	}, nil
 }
 
+func loadWorkingTreeLayer(repoPath string, layer workingTreeLayer) (snapshot, error) {
+	switch layer {
+	case layerHead:
+		head, err := git.ResolveCommit(repoPath, "HEAD")
+		if err != nil {
+			return snapshot{}, err
+		}
+		files, err := git.GetCommitTreeFiles(repoPath, head)
+		if err != nil {
+			return snapshot{}, err
+		}
+		return snapshot{
+			ref:         "HEAD",
+			label:       snapshotLabel{name: "HEAD", commit: head},
+			filePaths:   files,
+			contentRead: git.GitCommitContentReader(repoPath, head),
+		}, nil
+	case layerIndex:
+		files, err := git.ListTrackedFiles(repoPath)
+		if err != nil {
+			return snapshot{}, err
+		}
+		return snapshot{ref: "INDEX", label: snapshotLabel{name: "index"}, filePaths: files, contentRead: git.GitIndexContentReader(repoPath)}, nil
+	case layerTracked:
+		files, err := loadWorkingSnapshotFiles(repoPath, false)
+		if err != nil {
+			return snapshot{}, err
+		}
+		return snapshot{ref: "WORKING_TREE_TRACKED", label: snapshotLabel{name: "working tree (tracked files)"}, filePaths: files, contentRead: vcs.FilesystemContentReader()}, nil
+	case layerUntracked:
+		files, err := loadWorkingSnapshotFiles(repoPath, true)
+		if err != nil {
+			return snapshot{}, err
+		}
+		return snapshot{ref: "WORKING_TREE", label: snapshotLabel{name: "working tree"}, filePaths: files, contentRead: vcs.FilesystemContentReader()}, nil
+	default:
+		return snapshot{}, fmt.Errorf("unknown working tree layer: %d", layer)
+	}
+}
+
+// workingTreeChangedFiles returns the files changed between the layers compared for the selection.
+func workingTreeChangedFiles(repoPath string, selection snapshotSelection) ([]string, error) {
+	baseLayer, targetLayer := selection.layers()
+	sources := []struct {
+		layer workingTreeLayer
+		list  func(string) ([]string, error)
+	}{
+		{layerIndex, git.GetStagedFiles},
+		{layerTracked, git.GetUnstagedFiles},
+		{layerUntracked, git.ListUntrackedFiles},
+	}
+
+	var changed []string
+	for _, source := range sources {
+		if source.layer <= baseLayer || source.layer > targetLayer {
+			continue
+		}
+		files, err := source.list(repoPath)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, files...)
+	}
+	return changed, nil
+}
+
 func resolveCommitModeSnapshots(repoPath string, comparison commitComparison) (snapshotPair, error) {
 	if comparison.baseRef != "" {
 		baseFiles, err := git.GetCommitTreeFiles(repoPath, comparison.baseRef)
@@ -79,11 +175,13 @@ func resolveCommitModeSnapshots(repoPath string, comparison commitComparison) (s
 			mode: diffModeCommit,
 			base: snapshot{
 				ref:         comparison.baseRef,
+				label:       snapshotLabel{name: comparison.baseName, commit: comparison.baseRef},
 				filePaths:   baseFiles,
 				contentRead: git.GitCommitContentReader(repoPath, comparison.baseRef),
 			},
 			target: snapshot{
 				ref:         comparison.targetRef,
+				label:       snapshotLabel{name: comparison.targetName, commit: comparison.targetRef},
 				filePaths:   targetFiles,
 				contentRead: git.GitCommitContentReader(repoPath, comparison.targetRef),
 			},
@@ -100,7 +198,13 @@ func resolveCommitModeSnapshots(repoPath string, comparison commitComparison) (s
 		return snapshotPair{}, fmt.Errorf("failed to load target snapshot from %s: %w", comparison.targetRef, err)
 	}
 
-	base := snapshot{ref: "EMPTY", filePaths: nil, contentRead: nil}
+	if !hasParent {
+		if err := checkParentAvailable(repoPath, comparison.targetRef); err != nil {
+			return snapshotPair{}, err
+		}
+	}
+
+	base := snapshot{ref: emptySnapshotRef, label: snapshotLabel{name: "empty"}, filePaths: nil, contentRead: nil}
 	if hasParent {
 		baseFiles, err := git.GetCommitTreeFiles(repoPath, firstParent)
 		if err != nil {
@@ -108,6 +212,7 @@ func resolveCommitModeSnapshots(repoPath string, comparison commitComparison) (s
 		}
 		base = snapshot{
 			ref:         firstParent,
+			label:       snapshotLabel{name: snapshotLabel{name: comparison.targetName, commit: comparison.targetRef}.shortName() + "^", commit: firstParent},
 			filePaths:   baseFiles,
 			contentRead: git.GitCommitContentReader(repoPath, firstParent),
 		}
@@ -118,20 +223,24 @@ func resolveCommitModeSnapshots(repoPath string, comparison commitComparison) (s
 		base: base,
 		target: snapshot{
 			ref:         comparison.targetRef,
+			label:       snapshotLabel{name: comparison.targetName, commit: comparison.targetRef},
 			filePaths:   targetFiles,
 			contentRead: git.GitCommitContentReader(repoPath, comparison.targetRef),
 		},
 	}, nil
 }
 
-func loadWorkingSnapshotFiles(repoPath string) ([]string, error) {
+func loadWorkingSnapshotFiles(repoPath string, includeUntracked bool) ([]string, error) {
 	tracked, err := git.ListTrackedFiles(repoPath)
 	if err != nil {
 		return nil, err
 	}
-	untracked, err := git.ListUntrackedFiles(repoPath)
-	if err != nil {
-		return nil, err
+	var untracked []string
+	if includeUntracked {
+		untracked, err = git.ListUntrackedFiles(repoPath)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	files := make(map[string]struct{}, len(tracked)+len(untracked))
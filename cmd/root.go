@@ -1,10 +1,11 @@
 package cmd
 
 import (
+	"errors"
 	"log/slog"
 	"os"
-	"strconv"
 
+	cyclescmd "github.com/LegacyCodeHQ/clarity/cmd/cycles"
 	diffcmd "github.com/LegacyCodeHQ/clarity/cmd/diff"
 	"github.com/LegacyCodeHQ/clarity/cmd/languages"
 	setupcmd "github.com/LegacyCodeHQ/clarity/cmd/setup"
@@ -24,9 +25,6 @@ var buildDate = "unknown"
 // commit is set via build-time ldflags
 var commit = "unknown"
 
-// enableDevCommands is set via build-time ldflags
-var enableDevCommands = "false"
-
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "clarity",
@@ -65,20 +63,29 @@ func Execute() {
 		mcplogdlog.Error("command failed", map[string]any{
 			"error": err.Error(),
 		})
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 }
 
+// exitCode returns the status for a failed command. Errors that carry their own status, such as
+// diff policy violations, keep it; every other error exits with 1.
+func exitCode(err error) int {
+	var coder interface{ ExitCode() int }
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+	return 1
+}
+
 func init() {
 	// Register subcommands
 	rootCmd.AddCommand(show.Cmd)
 	rootCmd.AddCommand(languages.Cmd)
 	rootCmd.AddCommand(setupcmd.Cmd)
 	rootCmd.AddCommand(watchcmd.Cmd)
-	if isDevelopmentBuild(enableDevCommands) {
-		rootCmd.AddCommand(diffcmd.Cmd)
-		rootCmd.AddCommand(whycmd.Cmd)
-	}
+	rootCmd.AddCommand(cyclescmd.Cmd)
+	rootCmd.AddCommand(diffcmd.Cmd)
+	rootCmd.AddCommand(whycmd.Cmd)
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 
 	// Global flags inherited by all subcommands.
@@ -101,12 +108,3 @@ Build date: {{printf "%s" (index .Annotations "buildDate")}}
 Commit: {{printf "%s" (index .Annotations "commit")}}
 `)
 }
-
-func isDevelopmentBuild(devCommandsFlag string) bool {
-	devCommandsEnabled, err := strconv.ParseBool(devCommandsFlag)
-	if err != nil {
-		return false
-	}
-
-	return devCommandsEnabled
-}
@@ -1,6 +1,12 @@
 package cmd
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	diffcmd "github.com/LegacyCodeHQ/clarity/cmd/diff"
+)
 
 func TestRootCommand_AlwaysRegistersWatch(t *testing.T) {
 	t.Parallel()
@@ -14,28 +20,42 @@ func TestRootCommand_AlwaysRegistersWatch(t *testing.T) {
 	t.Fatal("expected watch command to be registered")
 }
 
-func TestIsDevelopmentBuild(t *testing.T) {
+func TestRootCommand_AlwaysRegistersCycles(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "cycles" {
+			return
+		}
+	}
+
+	t.Fatal("expected cycles command to be registered")
+}
+
+func TestRootCommand_AlwaysRegistersDiffAndWhy(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name            string
-		devCommandsFlag string
-		want            bool
-	}{
-		{name: "flag disabled", devCommandsFlag: "false", want: false},
-		{name: "flag enabled", devCommandsFlag: "true", want: true},
-		{name: "invalid flag", devCommandsFlag: "not-a-bool", want: false},
-		{name: "empty flag", devCommandsFlag: "", want: false},
+	registered := make(map[string]bool)
+	for _, c := range rootCmd.Commands() {
+		registered[c.Name()] = true
 	}
 
-	for _, tc := range tests {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
+	for _, name := range []string{"diff", "why"} {
+		if !registered[name] {
+			t.Fatalf("expected %s command to be registered", name)
+		}
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	if got := exitCode(errors.New("boom")); got != 1 {
+		t.Fatalf("exitCode(plain error) = %d, want 1", got)
+	}
 
-			if got := isDevelopmentBuild(tc.devCommandsFlag); got != tc.want {
-				t.Fatalf("isDevelopmentBuild(%q) = %v, want %v", tc.devCommandsFlag, got, tc.want)
-			}
-		})
+	violation := fmt.Errorf("diff: %w", &diffcmd.PolicyViolationError{Violations: []string{"new-cycle: a -> b -> a"}})
+	if got := exitCode(violation); got != diffcmd.ExitCodePolicyViolation {
+		t.Fatalf("exitCode(policy violation) = %d, want %d", got, diffcmd.ExitCodePolicyViolation)
 	}
 }
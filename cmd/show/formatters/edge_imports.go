@@ -0,0 +1,65 @@
+package formatters
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// importLocationLabel renders an import statement as `file:line:col "specifier"` using the base name.
+func importLocationLabel(location depgraph.ImportLocation) string {
+	return fmt.Sprintf("%s:%d:%d %q", filepath.Base(location.File), location.Line, location.Column, location.Specifier)
+}
+
+// importLocationLabels renders each import statement behind an edge with importLocationLabel.
+func importLocationLabels(edgeMetadata depgraph.EdgeMetadata) []string {
+	labels := make([]string, 0, len(edgeMetadata.Imports))
+	for _, location := range edgeMetadata.Imports {
+		labels = append(labels, importLocationLabel(location))
+	}
+	return labels
+}
+
+// ExplainEdges lists every edge of g followed by the import statements that create it.
+// Edges without a located import, such as Go files sharing a package, say so explicitly, and
+// edges from languages that do not locate imports name the file extension instead.
+func ExplainEdges(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return "", err
+	}
+
+	nodes := sortedNodes(adjacency)
+	names := buildGraphNodeNames(g, nodes, opts.NodeNames)
+
+	var sb strings.Builder
+	for _, source := range nodes {
+		for _, dep := range sortedDependencies(adjacency, source) {
+			sb.WriteString(fmt.Sprintf("%s -> %s\n", names[source], names[dep]))
+			labels := importLocationLabels(g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}])
+			if len(labels) == 0 {
+				sb.WriteString(fmt.Sprintf("  %s\n", missingImportLabel(g.Meta.Files[source])))
+				continue
+			}
+			for _, label := range labels {
+				sb.WriteString(fmt.Sprintf("  %s\n", label))
+			}
+		}
+	}
+
+	if sb.Len() == 0 {
+		return "No dependencies found.\n", nil
+	}
+	return sb.String(), nil
+}
+
+// missingImportLabel explains an edge without located imports, telling languages that do not
+// locate imports apart from edges that no import statement creates.
+func missingImportLabel(source depgraph.FileMetadata) string {
+	if len(source.Members) == 0 && source.Extension != "" && !depgraph.LocatesImports(source.Extension) {
+		return fmt.Sprintf("(import statements are not located for %s files)", source.Extension)
+	}
+	return "(no import statement located)"
+}
@@ -0,0 +1,92 @@
+package formatters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFileGraphWithImports(t *testing.T) depgraph.FileDependencyGraph {
+	t.Helper()
+	graph := testFileGraph(t, map[string][]string{
+		"/project/app.ts":    {"/project/user.ts", "/project/util.ts"},
+		"/project/user.ts":   {},
+		"/project/util.ts":   {},
+		"/project/README.md": {},
+	}, nil)
+	depgraph.AttachImportLocations(graph, depgraph.ImportIndex{
+		{From: "/project/app.ts", To: "/project/user.ts"}: {
+			{File: "/project/app.ts", Line: 2, Column: 1, Specifier: "./user"},
+			{File: "/project/app.ts", Line: 5, Column: 1, Specifier: "./user.ts"},
+		},
+	})
+	return graph
+}
+
+func TestExplainEdges_ListsImportStatements(t *testing.T) {
+	output, err := ExplainEdges(testFileGraphWithImports(t), RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, `app.ts -> user.ts
+  app.ts:2:1 "./user"
+  app.ts:5:1 "./user.ts"
+app.ts -> util.ts
+  (no import statement located)
+`, output)
+}
+
+func TestExplainEdges_NoDependencies(t *testing.T) {
+	output, err := ExplainEdges(testFileGraph(t, map[string][]string{"/project/a.go": {}}, nil), RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "No dependencies found.\n", output)
+}
+
+func TestDependencyGraph_ToDOT_AddsImportTooltips(t *testing.T) {
+	output, err := dotFormatter{}.Format(testFileGraphWithImports(t), RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, `"app.ts" -> "user.ts" [tooltip="app.ts:2:1 \"./user\"\napp.ts:5:1 \"./user.ts\""];`)
+	assert.Contains(t, output, `"app.ts" -> "util.ts";`)
+}
+
+func TestDependencyGraph_ToMermaid_AddsImportLinkComments(t *testing.T) {
+	output, err := mermaidFormatter{}.Format(testFileGraphWithImports(t), RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "    n1 --> n2\n    %% app.ts:2:1 \"./user\"\n    %% app.ts:5:1 \"./user.ts\"\n    n1 --> n3\n")
+}
+
+func TestExplainEdges_NamesLanguagesThatDoNotLocateImports(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/App.java":  {"/project/User.java"},
+		"/project/User.java": {},
+	}, nil)
+
+	output, err := ExplainEdges(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, `App.java -> User.java
+  (import statements are not located for .java files)
+`, output)
+}
+
+func TestGraphMLFormatter_ExportsImportStatements(t *testing.T) {
+	output, err := graphMLFormatter{}.Format(testFileGraphWithImports(t), RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, `<key id="imports" for="edge" attr.name="imports" attr.type="string"></key>`)
+	assert.Equal(t, 1, strings.Count(output, `<data key="imports">`))
+	assert.Contains(t, output, `<data key="imports">app.ts:2:1 &#34;./user&#34;&#xA;app.ts:5:1 &#34;./user.ts&#34;</data>`)
+}
+
+func TestGEXFFormatter_ExportsImportStatements(t *testing.T) {
+	output, err := gexfFormatter{}.Format(testFileGraphWithImports(t), RenderOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, strings.Count(output, `<attvalue for="imports"`))
+	assert.Contains(t, output, `<attvalue for="imports" value="app.ts:2:1 &#34;./user&#34;&#xA;app.ts:5:1 &#34;./user.ts&#34;"></attvalue>`)
+}
@@ -2,6 +2,7 @@ package formatters
 
 import (
 	"fmt"
+	"io/fs"
 
 	"github.com/LegacyCodeHQ/clarity/depgraph"
 )
@@ -10,6 +11,30 @@ type dotFormatter struct{}
 
 type mermaidFormatter struct{}
 
+type plantUMLFormatter struct{}
+
+type d2Formatter struct{}
+
+type graphMLFormatter struct{}
+
+type gexfFormatter struct{}
+
+type svgFormatter struct{}
+
+type pngFormatter struct{}
+
+type dsmFormatter struct{}
+
+type dsmCSVFormatter struct{}
+
+type dsmHTMLFormatter struct{}
+
+type textFormatter struct{}
+
+type htmlFormatter struct {
+	viewer fs.FS
+}
+
 // Formatter is the interface that all graph formatters must implement.
 type Formatter interface {
 	// Format converts a dependency graph to a formatted string representation.
@@ -31,6 +56,28 @@ func NewFormatter(format string) (Formatter, error) {
 		return dotFormatter{}, nil
 	case OutputFormatMermaid:
 		return mermaidFormatter{}, nil
+	case OutputFormatPlantUML:
+		return plantUMLFormatter{}, nil
+	case OutputFormatD2:
+		return d2Formatter{}, nil
+	case OutputFormatGraphML:
+		return graphMLFormatter{}, nil
+	case OutputFormatGEXF:
+		return gexfFormatter{}, nil
+	case OutputFormatSVG:
+		return svgFormatter{}, nil
+	case OutputFormatPNG:
+		return pngFormatter{}, nil
+	case OutputFormatDSM:
+		return dsmFormatter{}, nil
+	case OutputFormatDSMCSV:
+		return dsmCSVFormatter{}, nil
+	case OutputFormatDSMHTML:
+		return dsmHTMLFormatter{}, nil
+	case OutputFormatText:
+		return textFormatter{}, nil
+	case OutputFormatHTML:
+		return nil, fmt.Errorf("html format requires the viewer assets, use NewHTMLFormatter")
 	case endOfSupportedFormatsMarker:
 		return nil, fmt.Errorf("unknown format: %s (valid options: %s)", format, SupportedFormats())
 	default:
@@ -38,10 +85,38 @@ func NewFormatter(format string) (Formatter, error) {
 	}
 }
 
+// NewHTMLFormatter creates a Formatter that writes a self-contained HTML report by inlining the
+// built watch viewer (index.html and its assets/ directory) from viewer.
+func NewHTMLFormatter(viewer fs.FS) Formatter {
+	return htmlFormatter{viewer: viewer}
+}
+
 // RenderOptions contains output-specific rendering options.
 type RenderOptions struct {
 	// Label is an optional title or label for the graph output.
 	Label string
 	// Direction is the layout direction for the graph.
 	Direction GraphDirection
+	// Roots are the files the graph was focused on with --file. Text output prints a
+	// dependency tree for each root, graph formats outline roots to set them apart from
+	// the files discovered around them, and export formats flag them with isRoot.
+	Roots []string
+	// Endpoints are the files passed to --between. Text output lists the paths between them.
+	Endpoints []string
+	// Encodings are optional visual encodings of Metrics that DOT and Mermaid output apply
+	// to nodes, together with a legend.
+	Encodings []NodeEncoding
+	// Metrics holds the per-node values that Encodings scale by. When nil, they are derived
+	// from the change stats in the graph metadata.
+	Metrics map[string]NodeMetric
+	// NodeNames replaces the default display names of the nodes it lists, e.g. with names
+	// rendered from --node-label. They only change labels: DOT and Mermaid node IDs stay on
+	// the default names, so the same name may label several nodes.
+	NodeNames map[string]string
+	// MetricLabel names the Churn metric in the legend, e.g. "commits in history".
+	// It defaults to "lines changed".
+	MetricLabel string
+	// MetricWindow names the history the heatmap's net lines come from, e.g. "since 1 year
+	// ago". The heatmap always shades net lines, even when MetricLabel counts commits.
+	MetricWindow string
 }
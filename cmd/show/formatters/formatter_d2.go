@@ -0,0 +1,115 @@
+package formatters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// d2Directions maps graph directions to D2 layout directions.
+var d2Directions = map[GraphDirection]string{
+	DirectionLR: "right",
+	DirectionRL: "left",
+	DirectionTB: "down",
+	DirectionBT: "up",
+}
+
+// Format converts the dependency graph to a D2 diagram.
+func (f d2Formatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return "", err
+	}
+
+	dir := opts.Direction
+	if dir == "" {
+		dir = DefaultDirection
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("direction: %s\n", d2Directions[dir]))
+	if opts.Label != "" {
+		sb.WriteString(fmt.Sprintf("title: %q {\n", opts.Label))
+		sb.WriteString("  shape: text\n")
+		sb.WriteString("  near: top-left\n")
+		sb.WriteString("}\n")
+	}
+
+	if summaries := cycleSummaries(g); len(summaries) > 0 {
+		sb.WriteString("\n# Cyclic paths:\n")
+		for _, summary := range summaries {
+			sb.WriteString(fmt.Sprintf("# %s\n", summary))
+		}
+	}
+	if summaries := cycleBreakSummaries(g); len(summaries) > 0 {
+		sb.WriteString("\n# Suggested cycle breaks:\n")
+		for _, summary := range summaries {
+			sb.WriteString(fmt.Sprintf("# %s\n", summary))
+		}
+	}
+	cycleNodes := cycleNodeSet(g)
+	rootNodes := rootNodeSet(opts.Roots)
+
+	filePaths := sortedNodes(adjacency)
+	nodeNames := buildGraphNodeNames(g, filePaths, opts.NodeNames)
+	fillColors := nodeFillColors(g, filePaths)
+
+	// Node IDs avoid D2's use of dots in keys as a nesting separator.
+	nodeIDs := make(map[string]string, len(filePaths))
+	for i, source := range filePaths {
+		nodeID := fmt.Sprintf("n%d", i)
+		nodeIDs[source] = nodeID
+
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("%s: %q {\n", nodeID, nodeLabel(nodeNames[source], g.Meta.Files[source], "\n")))
+		sb.WriteString(fmt.Sprintf("  style.fill: %s\n", fillColors[source]))
+		if cycleNodes[source] {
+			sb.WriteString("  style.stroke: red\n")
+			sb.WriteString("  style.stroke-width: 3\n")
+		}
+		if rootNodes[source] {
+			sb.WriteString("  style.double-border: true\n")
+		}
+		sb.WriteString("}\n")
+	}
+
+	var edgesSB strings.Builder
+	for _, source := range filePaths {
+		for _, dep := range sortedDependencies(adjacency, source) {
+			edgeMD := g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}]
+
+			edgesSB.WriteString(fmt.Sprintf("%s -> %s", nodeIDs[source], nodeIDs[dep]))
+			label := edgeLabel(edgeMD)
+			if label != "" {
+				edgesSB.WriteString(fmt.Sprintf(": %q", label))
+			}
+			if edgeMD.InCycle || edgeMD.Relation != "" {
+				if label == "" {
+					edgesSB.WriteString(":")
+				}
+				edgesSB.WriteString(" {\n")
+				if edgeMD.InCycle {
+					edgesSB.WriteString("  style.stroke: red\n")
+				}
+				edgesSB.WriteString("  style.stroke-dash: 5\n")
+				if edgeMD.BreaksCycle {
+					edgesSB.WriteString("  style.stroke-width: 3\n")
+				}
+				edgesSB.WriteString("}")
+			}
+			edgesSB.WriteString("\n")
+		}
+	}
+	if edgesSB.Len() > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(edgesSB.String())
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// GenerateURL is not supported for D2 output.
+func (f d2Formatter) GenerateURL(output string) (string, bool) {
+	return "", false
+}
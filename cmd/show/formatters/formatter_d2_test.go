@@ -0,0 +1,130 @@
+package formatters
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/internal/testhelpers"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestD2Formatter_BasicDiagram(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.dart":  {"/project/utils.dart"},
+		"/project/utils.dart": {},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_WithLabel(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go": {"/project/utils.go"},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{Label: "clarity • abc1234 • 2 files"})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_DirectionTB(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go": {"/project/utils.go"},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{Direction: DirectionTB})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_DirectionRL(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go": {"/project/utils.go"},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{Direction: DirectionRL})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_ColorsExtensionsAndTestFiles(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":      {"/project/utils.go", "/project/schema.proto"},
+		"/project/main_test.go": {"/project/main.go"},
+		"/project/utils.go":     {},
+		"/project/schema.proto": {},
+		"/project/web/app.ts":   {},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_HighlightsCycles(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {"/project/a.go"},
+		"/project/c.go": {"/project/a.go"},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_ShowsFileStats(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, map[string]vcs.FileStats{
+		"/project/main.go":  {Additions: 12, Deletions: 3},
+		"/project/utils.go": {Additions: 20, IsNew: true},
+	})
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_GroupedNodesShowEdgeWeights(t *testing.T) {
+	output, err := d2Formatter{}.Format(testGroupedFileGraph(t), RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.D2Goldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestD2Formatter_GenerateURL_NotSupported(t *testing.T) {
+	_, ok := d2Formatter{}.GenerateURL("a -> b")
+	require.False(t, ok)
+}
+
+func TestD2Formatter_OutlinesRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := d2Formatter{}.Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "n0: \"main.go\" {\n  style.fill: white\n  style.double-border: true\n}")
+	require.Contains(t, output, "n1: \"utils.go\" {\n  style.fill: white\n}")
+}
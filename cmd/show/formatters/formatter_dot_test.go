@@ -1,6 +1,8 @@
 package formatters
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/LegacyCodeHQ/clarity/depgraph"
@@ -17,6 +19,7 @@ func testFileGraph(t *testing.T, adjacency map[string][]string, stats map[string
 	t.Helper()
 	fileGraph, err := depgraph.NewFileDependencyGraph(testGraph(adjacency), stats, nil)
 	require.NoError(t, err)
+	require.NoError(t, depgraph.AttachCycleBreaks(fileGraph))
 	return fileGraph
 }
 
@@ -293,8 +296,24 @@ func TestDependencyGraph_ToDOT_HighlightsAllCycleEdgesInSCC(t *testing.T) {
 	require.Contains(t, output, "\"c.go\" [label=\"c.go\", style=filled, fillcolor=white, color=red];")
 	require.Contains(t, output, "\"a.go\" -> \"b.go\" [color=red, style=dashed];")
 	require.Contains(t, output, "\"a.go\" -> \"c.go\" [color=red, style=dashed];")
-	require.Contains(t, output, "\"b.go\" -> \"a.go\" [color=red, style=dashed];")
-	require.Contains(t, output, "\"c.go\" -> \"a.go\" [color=red, style=dashed];")
+	require.Contains(t, output, "\"b.go\" -> \"a.go\" [color=red, style=dashed, penwidth=2];")
+	require.Contains(t, output, "\"c.go\" -> \"a.go\" [color=red, style=dashed, penwidth=2];")
+}
+
+func TestDependencyGraph_ToDOT_ListsSuggestedCycleBreaks(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/hub.go":  {"/project/core.go"},
+		"/project/core.go": {"/project/a.go", "/project/b.go"},
+		"/project/a.go":    {"/project/hub.go"},
+		"/project/b.go":    {"/project/hub.go"},
+	}, nil)
+
+	output, err := dotFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "  // Suggested cycle breaks:\n  // C1: hub.go -> core.go (2 cycles)\n")
+	require.Contains(t, output, "\"hub.go\" -> \"core.go\" [color=red, style=dashed, penwidth=2];")
+	require.Contains(t, output, "\"a.go\" -> \"hub.go\" [color=red, style=dashed];")
 }
 
 func TestDependencyGraph_ToDOT_DuplicateBaseNamesStayDistinct(t *testing.T) {
@@ -311,3 +330,49 @@ func TestDependencyGraph_ToDOT_DuplicateBaseNamesStayDistinct(t *testing.T) {
 	g := testhelpers.DotGoldie(t)
 	g.Assert(t, t.Name(), []byte(output))
 }
+
+func TestDependencyGraph_ToDOT_GroupedNodesShowEdgeWeights(t *testing.T) {
+	graph := testGroupedFileGraph(t)
+
+	formatter := dotFormatter{}
+	output, err := formatter.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.DotGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func testGroupedFileGraph(t *testing.T) depgraph.FileDependencyGraph {
+	t.Helper()
+	fileGraph := testFileGraph(t, map[string][]string{
+		"/project/api/handler.go":   {"/project/store/db.go", "/project/store/cache.go"},
+		"/project/api/routes.go":    {"/project/store/db.go", "/project/api/handler.go"},
+		"/project/store/db.go":      {"/project/api/routes.go"},
+		"/project/store/cache.go":   {},
+		"/project/store/db_test.go": {"/project/store/db.go"},
+		"/project/web/app.ts":       {},
+	}, map[string]vcs.FileStats{
+		"/project/store/db.go":    {Additions: 5, Deletions: 2},
+		"/project/store/cache.go": {Additions: 1},
+	})
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, func(filePath string) string {
+		return strings.TrimPrefix(filepath.Dir(filePath), "/project/") + "/"
+	})
+	require.NoError(t, err)
+	require.NoError(t, depgraph.AttachCycleBreaks(grouped))
+	return grouped
+}
+
+func TestDependencyGraph_ToDOT_OutlinesRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := dotFormatter{}.Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "\"main.go\" [label=\"main.go\", style=filled, fillcolor=white, peripheries=2];")
+	require.Contains(t, output, "\"utils.go\" [label=\"utils.go\", style=filled, fillcolor=white];")
+}
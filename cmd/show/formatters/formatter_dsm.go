@@ -0,0 +1,244 @@
+package formatters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// dsmMatrix is a design structure matrix: row i depends on column j when cells[i][j] > 0.
+// Nodes are ordered by dependency layer, so marks above the diagonal are back-edges.
+type dsmMatrix struct {
+	names  []string
+	paths  []string
+	layers []int
+	cells  [][]int
+	// weighted is true when the graph is grouped and cells carry file-edge counts.
+	weighted bool
+}
+
+func buildDSM(g depgraph.FileDependencyGraph, opts RenderOptions) (dsmMatrix, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return dsmMatrix{}, err
+	}
+
+	var m dsmMatrix
+	for layerIndex, layer := range depgraph.DependencyLayers(adjacency) {
+		for _, node := range layer.Nodes() {
+			m.paths = append(m.paths, node)
+			m.layers = append(m.layers, layerIndex)
+		}
+	}
+
+	nodeNames := buildGraphNodeNames(g, sortedNodes(adjacency), opts.NodeNames)
+	positions := make(map[string]int, len(m.paths))
+	for i, node := range m.paths {
+		positions[node] = i
+		m.names = append(m.names, nodeNames[node])
+	}
+
+	m.cells = make([][]int, len(m.paths))
+	for i := range m.cells {
+		m.cells[i] = make([]int, len(m.paths))
+	}
+	for _, source := range m.paths {
+		for _, dep := range adjacency[source] {
+			count := 1
+			if weight := g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}].Weight; weight > 0 {
+				count = weight
+				m.weighted = true
+			}
+			m.cells[positions[source]][positions[dep]] = count
+		}
+	}
+	return m, nil
+}
+
+// cellText renders a dependency mark: the edge count for grouped graphs, mark otherwise.
+func (m dsmMatrix) cellText(row, col int, mark string) string {
+	count := m.cells[row][col]
+	if count == 0 {
+		return ""
+	}
+	if m.weighted {
+		return strconv.Itoa(count)
+	}
+	return mark
+}
+
+// Format renders the dependency graph as a text design structure matrix.
+func (f dsmFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	m, err := buildDSM(g, opts)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if opts.Label != "" {
+		sb.WriteString(opts.Label + "\n")
+	}
+	sb.WriteString("Rows depend on columns. Marks above the diagonal (*) are back-edges in cycles.\n")
+	if len(m.paths) == 0 {
+		return sb.String(), nil
+	}
+	sb.WriteString("\n")
+
+	indexWidth := len(strconv.Itoa(len(m.paths)))
+	layerWidth := max(len("Layer"), len(strconv.Itoa(m.layers[len(m.layers)-1])))
+	nameWidth := len("File")
+	cellWidth := indexWidth
+	for i, name := range m.names {
+		nameWidth = max(nameWidth, len(name))
+		for j := range m.paths {
+			cellWidth = max(cellWidth, len(dsmTextCell(m, i, j)))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("%-*s  %*s  %-*s", layerWidth, "Layer", indexWidth, "#", nameWidth, "File"))
+	for j := range m.paths {
+		sb.WriteString(fmt.Sprintf(" %*d", cellWidth, j+1))
+	}
+	sb.WriteString("\n")
+
+	for i, name := range m.names {
+		sb.WriteString(fmt.Sprintf("%*d  %*d  %-*s", layerWidth, m.layers[i], indexWidth, i+1, nameWidth, name))
+		for j := range m.paths {
+			sb.WriteString(fmt.Sprintf(" %*s", cellWidth, dsmTextCell(m, i, j)))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String(), nil
+}
+
+// dsmTextCell marks the diagonal with '-', empty cells with '.', and back-edges with a trailing '*'.
+func dsmTextCell(m dsmMatrix, row, col int) string {
+	if row == col {
+		if m.cells[row][col] > 0 {
+			return m.cellText(row, col, "x") + "*"
+		}
+		return "-"
+	}
+	text := m.cellText(row, col, "x")
+	if text == "" {
+		return "."
+	}
+	if col > row {
+		return text + "*"
+	}
+	return text
+}
+
+// GenerateURL returns false because DSM output has no online viewer.
+func (f dsmFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
+
+// Format renders the design structure matrix as CSV with one row and one column per node.
+func (f dsmCSVFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	m, err := buildDSM(g, opts)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := append([]string{"layer", "file"}, m.names...)
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+	for i, name := range m.names {
+		record := []string{strconv.Itoa(m.layers[i]), name}
+		for j := range m.paths {
+			record = append(record, m.cellText(i, j, "1"))
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// GenerateURL returns false because DSM output has no online viewer.
+func (f dsmCSVFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
+
+const dsmHTMLStyle = `body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 12px; margin: 16px; }
+h1 { font-size: 14px; font-family: Courier, monospace; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ddd; min-width: 20px; height: 20px; text-align: center; padding: 0 4px; }
+th.file { text-align: left; font-weight: normal; white-space: nowrap; }
+tr.layer-start > * { border-top: 2px solid #555; }
+td.diagonal { background: #e0e0e0; }
+td.forward { background: #cfe3f7; }
+td.back { background: #f4b6b6; color: #8b0000; font-weight: bold; }`
+
+// Format renders the design structure matrix as a standalone HTML table.
+func (f dsmHTMLFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	m, err := buildDSM(g, opts)
+	if err != nil {
+		return "", err
+	}
+
+	title := opts.Label
+	if title == "" {
+		title = "Design Structure Matrix"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"UTF-8\" />\n")
+	sb.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	sb.WriteString(fmt.Sprintf("<style>\n%s\n</style>\n</head>\n<body>\n", dsmHTMLStyle))
+	sb.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(title)))
+	sb.WriteString("<p>Rows depend on columns. Red cells above the diagonal are back-edges in cycles.</p>\n")
+	sb.WriteString("<table>\n<tr><th>Layer</th><th class=\"file\">File</th>")
+	for j := range m.paths {
+		sb.WriteString(fmt.Sprintf("<th title=\"%s\">%d</th>", html.EscapeString(m.names[j]), j+1))
+	}
+	sb.WriteString("</tr>\n")
+
+	for i, name := range m.names {
+		if i > 0 && m.layers[i] != m.layers[i-1] {
+			sb.WriteString("<tr class=\"layer-start\">")
+		} else {
+			sb.WriteString("<tr>")
+		}
+		sb.WriteString(fmt.Sprintf("<td>%d</td><th class=\"file\" title=\"%s\">%d %s</th>",
+			m.layers[i], html.EscapeString(m.paths[i]), i+1, html.EscapeString(name)))
+		for j := range m.paths {
+			text := m.cellText(i, j, "&bull;")
+			switch {
+			case i == j && text == "":
+				sb.WriteString("<td class=\"diagonal\"></td>")
+			case text == "":
+				sb.WriteString("<td></td>")
+			case j >= i:
+				sb.WriteString(fmt.Sprintf("<td class=\"back\">%s</td>", text))
+			default:
+				sb.WriteString(fmt.Sprintf("<td class=\"forward\">%s</td>", text))
+			}
+		}
+		sb.WriteString("</tr>\n")
+	}
+	sb.WriteString("</table>\n</body>\n</html>")
+
+	return sb.String(), nil
+}
+
+// GenerateURL returns false because DSM output has no online viewer.
+func (f dsmHTMLFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
@@ -0,0 +1,66 @@
+package formatters
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/internal/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+func testLayeredFileGraph(t *testing.T) map[string][]string {
+	t.Helper()
+	return map[string][]string{
+		"/project/main.go":      {"/project/server.go", "/project/config.go"},
+		"/project/main_test.go": {"/project/main.go"},
+		"/project/server.go":    {"/project/store.go"},
+		"/project/store.go":     {"/project/server.go"},
+		"/project/config.go":    {},
+	}
+}
+
+func TestDSMFormatter_OrdersFilesByLayer(t *testing.T) {
+	graph := testFileGraph(t, testLayeredFileGraph(t), nil)
+
+	output, err := dsmFormatter{}.Format(graph, RenderOptions{Label: "clarity • abc1234 • 5 files"})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestDSMFormatter_GroupedGraphShowsEdgeCounts(t *testing.T) {
+	output, err := dsmFormatter{}.Format(testGroupedFileGraph(t), RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestDSMCSVFormatter_OrdersFilesByLayer(t *testing.T) {
+	graph := testFileGraph(t, testLayeredFileGraph(t), nil)
+
+	output, err := dsmCSVFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	require.Equal(t, `layer,file,config.go,server.go,store.go,main.go,main_test.go
+0,config.go,,,,,
+0,server.go,,,1,,
+0,store.go,,1,,,
+1,main.go,1,1,,,
+2,main_test.go,,,,1,`, output)
+}
+
+func TestDSMHTMLFormatter_MarksBackEdges(t *testing.T) {
+	output, err := dsmHTMLFormatter{}.Format(testGroupedFileGraph(t), RenderOptions{Label: "clarity <grouped>"})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+	require.Contains(t, output, "<title>clarity &lt;grouped&gt;</title>")
+}
+
+func TestDSMFormatter_EmptyGraph(t *testing.T) {
+	output, err := dsmFormatter{}.Format(testFileGraph(t, map[string][]string{}, nil), RenderOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "Rows depend on columns. Marks above the diagonal (*) are back-edges in cycles.\n", output)
+}
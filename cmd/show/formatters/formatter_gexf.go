@@ -0,0 +1,157 @@
+package formatters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+type gexfDocument struct {
+	XMLName xml.Name  `xml:"gexf"`
+	XMLNS   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Meta    *gexfMeta `xml:"meta,omitempty"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfMeta struct {
+	Description string `xml:"description"`
+}
+
+type gexfGraph struct {
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Mode            string           `xml:"mode,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	ID        string          `xml:"id,attr"`
+	Label     string          `xml:"label,attr"`
+	AttValues []gexfAttrValue `xml:"attvalues>attvalue"`
+}
+
+type gexfEdge struct {
+	ID        string          `xml:"id,attr"`
+	Source    string          `xml:"source,attr"`
+	Target    string          `xml:"target,attr"`
+	Weight    int             `xml:"weight,attr"`
+	AttValues []gexfAttrValue `xml:"attvalues>attvalue"`
+}
+
+type gexfAttrValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+var gexfAttributeClasses = []gexfAttributes{
+	{
+		Class: "node",
+		Attributes: []gexfAttribute{
+			{ID: "path", Title: "path", Type: "string"},
+			{ID: "extension", Title: "extension", Type: "string"},
+			{ID: "isTest", Title: "isTest", Type: "boolean"},
+			{ID: "additions", Title: "additions", Type: "integer"},
+			{ID: "deletions", Title: "deletions", Type: "integer"},
+			{ID: "isNew", Title: "isNew", Type: "boolean"},
+			{ID: "inCycle", Title: "inCycle", Type: "boolean"},
+			{ID: "members", Title: "members", Type: "integer"},
+			{ID: "isRoot", Title: "isRoot", Type: "boolean"},
+		},
+	},
+	{
+		Class: "edge",
+		Attributes: []gexfAttribute{
+			{ID: "inCycle", Title: "inCycle", Type: "boolean"},
+			{ID: "breaksCycle", Title: "breaksCycle", Type: "boolean"},
+			{ID: "relation", Title: "relation", Type: "string"},
+			{ID: "imports", Title: "imports", Type: "string"},
+		},
+	},
+}
+
+// Format converts the dependency graph to GEXF for graph analysis tools such as Gephi.
+func (f gexfFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return "", err
+	}
+
+	graph := gexfGraph{
+		DefaultEdgeType: "directed",
+		Mode:            "static",
+		Attributes:      gexfAttributeClasses,
+	}
+
+	for _, node := range exportNodes(g, adjacency, opts) {
+		graph.Nodes = append(graph.Nodes, gexfNode{
+			ID:    node.ID,
+			Label: node.Name,
+			AttValues: []gexfAttrValue{
+				{For: "path", Value: node.Path},
+				{For: "extension", Value: node.Extension},
+				{For: "isTest", Value: strconv.FormatBool(node.IsTest)},
+				{For: "additions", Value: strconv.Itoa(node.Additions)},
+				{For: "deletions", Value: strconv.Itoa(node.Deletions)},
+				{For: "isNew", Value: strconv.FormatBool(node.IsNew)},
+				{For: "inCycle", Value: strconv.FormatBool(node.InCycle)},
+				{For: "members", Value: strconv.Itoa(node.Members)},
+				{For: "isRoot", Value: strconv.FormatBool(node.IsRoot)},
+			},
+		})
+	}
+
+	for _, edge := range exportEdges(g, adjacency) {
+		attValues := []gexfAttrValue{
+			{For: "inCycle", Value: strconv.FormatBool(edge.InCycle)},
+			{For: "breaksCycle", Value: strconv.FormatBool(edge.BreaksCycle)},
+			{For: "relation", Value: edge.Relation},
+		}
+		if len(edge.Imports) > 0 {
+			attValues = append(attValues, gexfAttrValue{For: "imports", Value: strings.Join(edge.Imports, "\n")})
+		}
+		graph.Edges = append(graph.Edges, gexfEdge{
+			ID:        edge.ID,
+			Source:    edge.Source,
+			Target:    edge.Target,
+			Weight:    edge.Weight,
+			AttValues: attValues,
+		})
+	}
+
+	doc := gexfDocument{
+		XMLNS:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Graph:   graph,
+	}
+	if opts.Label != "" {
+		doc.Meta = &gexfMeta{Description: opts.Label}
+	}
+
+	output, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode GEXF: %w", err)
+	}
+
+	return xml.Header + string(output), nil
+}
+
+// GenerateURL is not supported for GEXF output.
+func (f gexfFormatter) GenerateURL(output string) (string, bool) {
+	return "", false
+}
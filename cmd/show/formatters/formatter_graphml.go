@@ -0,0 +1,133 @@
+package formatters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []graphMLData `xml:"data"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+var graphMLKeys = []graphMLKey{
+	{ID: "label", For: "graph", AttrName: "label", AttrType: "string"},
+	{ID: "name", For: "node", AttrName: "name", AttrType: "string"},
+	{ID: "path", For: "node", AttrName: "path", AttrType: "string"},
+	{ID: "extension", For: "node", AttrName: "extension", AttrType: "string"},
+	{ID: "isTest", For: "node", AttrName: "isTest", AttrType: "boolean"},
+	{ID: "additions", For: "node", AttrName: "additions", AttrType: "int"},
+	{ID: "deletions", For: "node", AttrName: "deletions", AttrType: "int"},
+	{ID: "isNew", For: "node", AttrName: "isNew", AttrType: "boolean"},
+	{ID: "inCycle", For: "node", AttrName: "inCycle", AttrType: "boolean"},
+	{ID: "members", For: "node", AttrName: "members", AttrType: "int"},
+	{ID: "isRoot", For: "node", AttrName: "isRoot", AttrType: "boolean"},
+	{ID: "edgeInCycle", For: "edge", AttrName: "inCycle", AttrType: "boolean"},
+	{ID: "breaksCycle", For: "edge", AttrName: "breaksCycle", AttrType: "boolean"},
+	{ID: "weight", For: "edge", AttrName: "weight", AttrType: "int"},
+	{ID: "relation", For: "edge", AttrName: "relation", AttrType: "string"},
+	{ID: "imports", For: "edge", AttrName: "imports", AttrType: "string"},
+}
+
+// Format converts the dependency graph to GraphML for graph analysis tools such as yEd and NetworkX.
+func (f graphMLFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return "", err
+	}
+
+	graph := graphMLGraph{ID: "dependencies", EdgeDefault: "directed"}
+	if opts.Label != "" {
+		graph.Data = append(graph.Data, graphMLData{Key: "label", Value: opts.Label})
+	}
+
+	for _, node := range exportNodes(g, adjacency, opts) {
+		graph.Nodes = append(graph.Nodes, graphMLNode{
+			ID: node.ID,
+			Data: []graphMLData{
+				{Key: "name", Value: node.Name},
+				{Key: "path", Value: node.Path},
+				{Key: "extension", Value: node.Extension},
+				{Key: "isTest", Value: strconv.FormatBool(node.IsTest)},
+				{Key: "additions", Value: strconv.Itoa(node.Additions)},
+				{Key: "deletions", Value: strconv.Itoa(node.Deletions)},
+				{Key: "isNew", Value: strconv.FormatBool(node.IsNew)},
+				{Key: "inCycle", Value: strconv.FormatBool(node.InCycle)},
+				{Key: "members", Value: strconv.Itoa(node.Members)},
+				{Key: "isRoot", Value: strconv.FormatBool(node.IsRoot)},
+			},
+		})
+	}
+
+	for _, edge := range exportEdges(g, adjacency) {
+		data := []graphMLData{
+			{Key: "edgeInCycle", Value: strconv.FormatBool(edge.InCycle)},
+			{Key: "breaksCycle", Value: strconv.FormatBool(edge.BreaksCycle)},
+			{Key: "weight", Value: strconv.Itoa(edge.Weight)},
+			{Key: "relation", Value: edge.Relation},
+		}
+		if len(edge.Imports) > 0 {
+			data = append(data, graphMLData{Key: "imports", Value: strings.Join(edge.Imports, "\n")})
+		}
+		graph.Edges = append(graph.Edges, graphMLEdge{
+			ID:     edge.ID,
+			Source: edge.Source,
+			Target: edge.Target,
+			Data:   data,
+		})
+	}
+
+	output, err := xml.MarshalIndent(graphMLDocument{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphMLKeys,
+		Graph: graph,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode GraphML: %w", err)
+	}
+
+	return xml.Header + string(output), nil
+}
+
+// GenerateURL is not supported for GraphML output.
+func (f graphMLFormatter) GenerateURL(output string) (string, bool) {
+	return "", false
+}
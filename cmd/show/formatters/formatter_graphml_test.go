@@ -0,0 +1,62 @@
+package formatters
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/internal/testhelpers"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphMLFormatter_ExportsFileMetadata(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":      {"/project/utils.go"},
+		"/project/main_test.go": {"/project/main.go"},
+		"/project/utils.go":     {},
+	}, map[string]vcs.FileStats{
+		"/project/main.go":  {Additions: 12, Deletions: 3},
+		"/project/utils.go": {Additions: 20, IsNew: true},
+	})
+
+	output, err := graphMLFormatter{}.Format(graph, RenderOptions{Label: "clarity • abc1234 • 3 files"})
+	require.NoError(t, err)
+
+	g := testhelpers.GraphMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestGraphMLFormatter_ExportsCyclesAndWeights(t *testing.T) {
+	output, err := graphMLFormatter{}.Format(testGroupedFileGraph(t), RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.GraphMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestGraphMLFormatter_EscapesSpecialCharacters(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/<odd> & \"quoted\".go": {},
+	}, nil)
+
+	output, err := graphMLFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	var doc struct{}
+	require.NoError(t, xml.Unmarshal([]byte(output), &doc))
+	require.Contains(t, output, "&lt;odd&gt; &amp;")
+}
+
+func TestGraphMLFormatter_FlagsRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := graphMLFormatter{}.Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "<data key=\"path\">/project/main.go</data>")
+	require.Equal(t, 1, strings.Count(output, "<data key=\"isRoot\">true</data>"))
+}
@@ -0,0 +1,183 @@
+package formatters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"regexp"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// DefaultHTMLReportTitle is the page title of HTML reports rendered without a label.
+const DefaultHTMLReportTitle = "Clarity Report"
+
+// htmlReportElementID is the id of the JSON script element the viewer reads in report mode.
+const htmlReportElementID = "clarity-report"
+
+var (
+	htmlScriptTagPattern = regexp.MustCompile(`(?i)<script\b[^>]*\bsrc="([^"]+)"[^>]*>\s*</script>`)
+	htmlLinkTagPattern   = regexp.MustCompile(`(?i)<link\b[^>]*>`)
+	htmlHrefPattern      = regexp.MustCompile(`(?i)\bhref="([^"]+)"`)
+	htmlRelPattern       = regexp.MustCompile(`(?i)\brel="([^"]+)"`)
+	htmlScriptEndPattern = regexp.MustCompile(`(?i)</script`)
+	htmlStyleEndPattern  = regexp.MustCompile(`(?i)</style`)
+)
+
+// htmlReport is the payload the viewer renders instead of connecting to a watch server.
+type htmlReport struct {
+	Title  string     `json:"title"`
+	DOT    string     `json:"dot"`
+	Cycles [][]string `json:"cycles"`
+}
+
+// Format renders the dependency graph as a single HTML file that embeds the watch viewer,
+// its scripts and styles, and the graph itself, so the report works offline.
+func (f htmlFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	if f.viewer == nil {
+		return "", fmt.Errorf("html format requires the viewer assets")
+	}
+
+	dot, err := dotFormatter{}.Format(g, opts)
+	if err != nil {
+		return "", err
+	}
+	cycles, err := htmlReportCycles(g)
+	if err != nil {
+		return "", err
+	}
+
+	title := opts.Label
+	if title == "" {
+		title = DefaultHTMLReportTitle
+	}
+	reportJSON, err := json.Marshal(htmlReport{Title: title, DOT: dot, Cycles: cycles})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode html report: %w", err)
+	}
+
+	page, err := f.renderIndex(title)
+	if err != nil {
+		return "", err
+	}
+	page, err = f.inlineAssets(page)
+	if err != nil {
+		return "", err
+	}
+
+	// encoding/json escapes '<', '>' and '&', so the payload cannot close the script element.
+	reportScript := fmt.Sprintf("<script type=\"application/json\" id=%q>%s</script>\n", htmlReportElementID, reportJSON)
+	if idx := strings.Index(strings.ToLower(page), "</head>"); idx >= 0 {
+		page = page[:idx] + reportScript + page[idx:]
+	} else {
+		page = reportScript + page
+	}
+
+	return page, nil
+}
+
+// GenerateURL returns false because HTML reports are meant to be shared as files.
+func (f htmlFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
+
+// renderIndex executes the viewer's index.html template with the report title,
+// the same way the watch server does.
+func (f htmlFormatter) renderIndex(title string) (string, error) {
+	indexContent, err := fs.ReadFile(f.viewer, "index.html")
+	if err != nil {
+		return "", fmt.Errorf("failed to read viewer index.html: %w", err)
+	}
+
+	tmpl, err := template.New("index").Parse(string(indexContent))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse viewer index.html: %w", err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, struct{ PageTitle string }{PageTitle: title}); err != nil {
+		return "", fmt.Errorf("failed to render viewer index.html: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+// inlineAssets replaces the viewer's script and stylesheet references with their contents and
+// drops other linked resources, so the page makes no requests.
+func (f htmlFormatter) inlineAssets(page string) (string, error) {
+	var inlineErr error
+
+	page = htmlScriptTagPattern.ReplaceAllStringFunc(page, func(tag string) string {
+		src := htmlScriptTagPattern.FindStringSubmatch(tag)[1]
+		content, err := f.readAsset(src)
+		if err != nil {
+			inlineErr = err
+			return tag
+		}
+		return fmt.Sprintf("<script type=\"module\">%s</script>", htmlScriptEndPattern.ReplaceAllString(content, `<\/script`))
+	})
+	if inlineErr != nil {
+		return "", inlineErr
+	}
+
+	page = htmlLinkTagPattern.ReplaceAllStringFunc(page, func(tag string) string {
+		relMatch := htmlRelPattern.FindStringSubmatch(tag)
+		hrefMatch := htmlHrefPattern.FindStringSubmatch(tag)
+		if relMatch == nil || hrefMatch == nil {
+			return tag
+		}
+		if !strings.EqualFold(relMatch[1], "stylesheet") {
+			// Icons and preload hints point at files that are not part of the report.
+			return ""
+		}
+		content, err := f.readAsset(hrefMatch[1])
+		if err != nil {
+			inlineErr = err
+			return tag
+		}
+		return fmt.Sprintf("<style>%s</style>", htmlStyleEndPattern.ReplaceAllString(content, `<\/style`))
+	})
+	if inlineErr != nil {
+		return "", inlineErr
+	}
+
+	return page, nil
+}
+
+// readAsset reads a built viewer asset referenced from index.html.
+func (f htmlFormatter) readAsset(ref string) (string, error) {
+	assetPath := strings.TrimPrefix(ref, "/")
+	if !strings.HasPrefix(assetPath, "assets/") {
+		return "", fmt.Errorf("viewer references unbuilt source %s (run 'make build-web')", ref)
+	}
+	content, err := fs.ReadFile(f.viewer, assetPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read viewer asset %s: %w", ref, err)
+	}
+	return string(content), nil
+}
+
+// htmlReportCycles lists each cyclic path using the node IDs of the DOT output, which the viewer
+// matches against the rendered graph. --node-label only changes the labels, not the IDs.
+func htmlReportCycles(g depgraph.FileDependencyGraph) ([][]string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return nil, err
+	}
+	nodeNames := buildGraphNodeNames(g, sortedNodes(adjacency), nil)
+
+	cycles := make([][]string, 0, len(g.Meta.Cycles))
+	for _, cycle := range g.Meta.Cycles {
+		if len(cycle.Path) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(cycle.Path))
+		for _, node := range cycle.Path {
+			names = append(names, nodeNames[node])
+		}
+		cycles = append(cycles, names)
+	}
+	return cycles, nil
+}
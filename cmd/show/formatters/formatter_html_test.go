@@ -0,0 +1,131 @@
+package formatters
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testViewerFS() fstest.MapFS {
+	return fstest.MapFS{
+		"index.html": {Data: []byte(`<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <link rel="icon" type="image/svg+xml" href="/vite.svg" />
+    <meta name="page-title" content="{{.PageTitle}}" />
+    <title>{{.PageTitle}}</title>
+    <script type="module" crossorigin src="/assets/index-abc123.js"></script>
+    <link rel="stylesheet" crossorigin href="/assets/index-abc123.css">
+  </head>
+  <body>
+    <div id="app"></div>
+  </body>
+</html>
+`)},
+		"assets/index-abc123.js":  {Data: []byte(`console.log("</script>");`)},
+		"assets/index-abc123.css": {Data: []byte(`body { color: red; }`)},
+	}
+}
+
+func extractHTMLReport(t *testing.T, output string) htmlReport {
+	t.Helper()
+	match := regexp.MustCompile(`<script type="application/json" id="clarity-report">(.*)</script>`).FindStringSubmatch(output)
+	require.NotNil(t, match, "expected report payload in:\n%s", output)
+
+	var report htmlReport
+	require.NoError(t, json.Unmarshal([]byte(match[1]), &report))
+	return report
+}
+
+func TestHTMLFormatter_InlinesViewerAssets(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := NewHTMLFormatter(testViewerFS()).Format(graph, RenderOptions{Label: "clarity • abc1234"})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "<title>clarity • abc1234</title>")
+	require.Contains(t, output, `<script type="module">console.log("<\/script>");</script>`)
+	require.Contains(t, output, "<style>body { color: red; }</style>")
+	require.NotContains(t, output, "/assets/")
+	require.NotContains(t, output, "/vite.svg")
+
+	report := extractHTMLReport(t, output)
+	require.Equal(t, "clarity • abc1234", report.Title)
+	require.Contains(t, report.DOT, `"main.go" -> "utils.go";`)
+	require.Empty(t, report.Cycles)
+}
+
+func TestHTMLFormatter_ReportsCyclesWithNodeNames(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {"/project/a.go"},
+	}, nil)
+
+	output, err := NewHTMLFormatter(testViewerFS()).Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	report := extractHTMLReport(t, output)
+	require.Equal(t, DefaultHTMLReportTitle, report.Title)
+	require.Equal(t, [][]string{{"a.go", "b.go"}}, report.Cycles)
+}
+
+func TestHTMLFormatter_ReportsCyclesWithNodeIDsUnderNodeLabels(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {"/project/a.go"},
+	}, nil)
+
+	output, err := NewHTMLFormatter(testViewerFS()).Format(graph, RenderOptions{
+		NodeNames: map[string]string{"/project/a.go": "pkg/a", "/project/b.go": "pkg/b"},
+	})
+	require.NoError(t, err)
+
+	report := extractHTMLReport(t, output)
+	require.Contains(t, report.DOT, "\"a.go\" [label=\"pkg/a\"")
+	require.Equal(t, [][]string{{"a.go", "b.go"}}, report.Cycles)
+}
+
+func TestHTMLFormatter_EscapesReportPayload(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/<script>.go": {},
+	}, nil)
+
+	output, err := NewHTMLFormatter(testViewerFS()).Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	require.NotContains(t, output, "<script>.go")
+	require.Contains(t, extractHTMLReport(t, output).DOT, "<script>.go")
+}
+
+func TestHTMLFormatter_UnbuiltViewerReturnsError(t *testing.T) {
+	viewer := fstest.MapFS{
+		"index.html": {Data: []byte(`<html><head></head><body><script type="module" src="/src/main.ts"></script></body></html>`)},
+	}
+	graph := testFileGraph(t, map[string][]string{"/project/main.go": {}}, nil)
+
+	_, err := NewHTMLFormatter(viewer).Format(graph, RenderOptions{})
+	require.ErrorContains(t, err, "make build-web")
+}
+
+func TestHTMLFormatter_GenerateURLIsUnsupported(t *testing.T) {
+	_, ok := NewHTMLFormatter(testViewerFS()).GenerateURL("<html></html>")
+	require.False(t, ok)
+}
+
+func TestHTMLFormatter_OutlinesRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := NewHTMLFormatter(testViewerFS()).Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	require.Contains(t, extractHTMLReport(t, output).DOT, "\"main.go\" [label=\"main.go\", style=filled, fillcolor=white, peripheries=2];")
+}
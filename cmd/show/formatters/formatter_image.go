@@ -0,0 +1,102 @@
+package formatters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/goccy/go-graphviz"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+)
+
+var (
+	imageFontOnce sync.Once
+	imageFontErr  error
+	imageFont     *opentype.Font
+)
+
+// Format renders the DOT graph to SVG with the embedded Graphviz build, so no `dot` binary is needed.
+func (f svgFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	return renderGraphImage(g, opts, graphviz.SVG)
+}
+
+// GenerateURL returns false because SVG output is a file, not a shareable link.
+func (f svgFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
+
+// Format renders the DOT graph to PNG with the embedded Graphviz build. The returned string holds
+// the binary image.
+func (f pngFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	return renderGraphImage(g, opts, graphviz.PNG)
+}
+
+// GenerateURL returns false because PNG output is a file, not a shareable link.
+func (f pngFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
+
+// renderGraphImage lays out the DOT output in-process and encodes it as format.
+func renderGraphImage(g depgraph.FileDependencyGraph, opts RenderOptions, format graphviz.Format) (string, error) {
+	dot, err := dotFormatter{}.Format(g, opts)
+	if err != nil {
+		return "", err
+	}
+	return renderDOTImage(dot, format)
+}
+
+// RenderDOTToSVG lays out any DOT graph in-process, for callers that build their own DOT.
+func RenderDOTToSVG(dot string) (string, error) {
+	return renderDOTImage(dot, graphviz.SVG)
+}
+
+func renderDOTImage(dot string, format graphviz.Format) (string, error) {
+	if err := useEmbeddedImageFont(); err != nil {
+		return "", err
+	}
+
+	ctx := context.Background()
+	gv, err := graphviz.New(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to start graphviz: %w", err)
+	}
+	defer gv.Close()
+
+	graph, err := graphviz.ParseBytes([]byte(dot))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse dot graph: %w", err)
+	}
+	defer graph.Close()
+
+	var rendered bytes.Buffer
+	if err := gv.Render(ctx, graph, format, &rendered); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", format, err)
+	}
+	return rendered.String(), nil
+}
+
+// useEmbeddedImageFont makes raster output use the bundled Go font instead of whatever fonts the
+// host has installed, so PNG output is identical on every machine.
+func useEmbeddedImageFont() error {
+	imageFontOnce.Do(func() {
+		imageFont, imageFontErr = opentype.Parse(goregular.TTF)
+		if imageFontErr != nil {
+			return
+		}
+		graphviz.SetFontLoader(func(_ context.Context, job *graphviz.Job, textFont *graphviz.TextFont) (font.Face, error) {
+			return opentype.NewFace(imageFont, &opentype.FaceOptions{
+				Size:    textFont.Size() * job.Zoom(),
+				DPI:     72,
+				Hinting: font.HintingNone,
+			})
+		})
+	})
+	if imageFontErr != nil {
+		return fmt.Errorf("failed to load embedded font: %w", imageFontErr)
+	}
+	return nil
+}
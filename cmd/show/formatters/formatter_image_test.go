@@ -0,0 +1,61 @@
+package formatters
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/internal/testhelpers"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSVGFormatter_RendersGraph(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":      {"/project/utils.go"},
+		"/project/main_test.go": {"/project/main.go"},
+		"/project/utils.go":     {},
+	}, map[string]vcs.FileStats{
+		"/project/main.go": {Additions: 12, Deletions: 3},
+	})
+
+	output, err := svgFormatter{}.Format(graph, RenderOptions{Label: "clarity • abc1234 • 3 files"})
+	require.NoError(t, err)
+
+	g := testhelpers.SVGGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestSVGFormatter_RendersCyclesAndWeights(t *testing.T) {
+	output, err := svgFormatter{}.Format(testGroupedFileGraph(t), RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.SVGGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPNGFormatter_RendersDeterministicImage(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	first, err := pngFormatter{}.Format(graph, RenderOptions{Label: "clarity"})
+	require.NoError(t, err)
+	second, err := pngFormatter{}.Format(graph, RenderOptions{Label: "clarity"})
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	img, err := png.Decode(bytes.NewReader([]byte(first)))
+	require.NoError(t, err)
+	require.Positive(t, img.Bounds().Dx())
+	require.Positive(t, img.Bounds().Dy())
+}
+
+func TestImageFormatters_GenerateURLIsUnsupported(t *testing.T) {
+	_, ok := svgFormatter{}.GenerateURL("<svg></svg>")
+	require.False(t, ok)
+
+	_, ok = pngFormatter{}.GenerateURL("")
+	require.False(t, ok)
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"path/filepath"
 	"sort"
 	"strings"
 
@@ -35,29 +34,14 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 	}
 	sb.WriteString(fmt.Sprintf("flowchart %s\n", dir.String()))
 
-	cycleNodes := make(map[string]bool)
-	if len(g.Meta.Cycles) > 0 {
-		for i, cycle := range g.Meta.Cycles {
-			if len(cycle.Path) == 0 {
-				continue
-			}
-
-			var cycleParts []string
-			for _, node := range cycle.Path {
-				cycleParts = append(cycleParts, filepath.Base(node))
-				cycleNodes[node] = true
-			}
-			cycleParts = append(cycleParts, filepath.Base(cycle.Path[0]))
-			sb.WriteString(fmt.Sprintf("%%%% C%d: %s\n", i+1, strings.Join(cycleParts, " -> ")))
-		}
+	for _, summary := range cycleSummaries(g) {
+		sb.WriteString(fmt.Sprintf("%%%% %s\n", summary))
 	}
-	for edge, md := range g.Meta.Edges {
-		if !md.InCycle {
-			continue
-		}
-		cycleNodes[edge.From] = true
-		cycleNodes[edge.To] = true
+	for _, summary := range cycleBreakSummaries(g) {
+		sb.WriteString(fmt.Sprintf("%%%% break %s\n", summary))
 	}
+	cycleNodes := cycleNodeSet(g)
+	rootNodes := rootNodeSet(opts.Roots)
 
 	// Collect and sort file paths for deterministic output
 	filePaths := make([]string, 0, len(adjacency))
@@ -65,7 +49,10 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 		filePaths = append(filePaths, source)
 	}
 	sort.Strings(filePaths)
-	nodeNames := BuildNodeNames(filePaths)
+	// Node IDs keep the default names, so --node-label text may repeat across nodes.
+	nodeNames := buildGraphNodeNames(g, filePaths, nil)
+	nodeLabels := buildGraphNodeNames(g, filePaths, opts.NodeNames)
+	encoder := newNodeEncoder(g, filePaths, opts)
 
 	// Create a mapping from node keys to valid Mermaid node IDs.
 	// Mermaid node IDs can't have dots or special characters.
@@ -82,7 +69,7 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 	// Count files by extension to find the majority extension
 	extensionCounts := make(map[string]int)
 	for _, source := range filePaths {
-		ext := filepath.Ext(filepath.Base(source))
+		ext := nodeExtension(g, source)
 		extensionCounts[ext]++
 	}
 
@@ -107,7 +94,7 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 	// Track all files that have the majority extension
 	filesWithMajorityExtension := make(map[string]bool)
 	for _, source := range filePaths {
-		ext := filepath.Ext(filepath.Base(source))
+		ext := nodeExtension(g, source)
 		if ext == majorityExtension {
 			filesWithMajorityExtension[source] = true
 		}
@@ -122,40 +109,20 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 		nodeID := nodeIDs[sourceNodeKey]
 
 		if !definedNodes[sourceNodeKey] {
-			// Build node label with file stats if available
-			nodeLabel := nodeNames[source]
-			if fileMetadata, ok := g.Meta.Files[source]; ok && fileMetadata.Stats != nil {
-				stats := *fileMetadata.Stats
-				labelPrefix := nodeLabel
-				if stats.IsNew {
-					labelPrefix = fmt.Sprintf("🪴 %s", labelPrefix)
-				}
-
-				if stats.Additions > 0 || stats.Deletions > 0 {
-					var statsParts []string
-					if stats.Additions > 0 {
-						statsParts = append(statsParts, fmt.Sprintf("+%d", stats.Additions))
-					}
-					if stats.Deletions > 0 {
-						statsParts = append(statsParts, fmt.Sprintf("-%d", stats.Deletions))
-					}
-					if len(statsParts) > 0 {
-						nodeLabel = fmt.Sprintf("%s<br/>%s", labelPrefix, strings.Join(statsParts, " "))
-					} else {
-						nodeLabel = labelPrefix
-					}
-				} else if stats.IsNew {
-					nodeLabel = labelPrefix
-				}
-			}
-
 			// Escape quotes in labels
-			nodeLabel = strings.ReplaceAll(nodeLabel, "\"", "#quot;")
+			label := strings.ReplaceAll(nodeLabel(nodeLabels[source], g.Meta.Files[source], "<br/>"), "\"", "#quot;")
 
-			sb.WriteString(fmt.Sprintf("    %s[\"%s\"]\n", nodeID, nodeLabel))
+			sb.WriteString(fmt.Sprintf("    %s[\"%s\"]\n", nodeID, label))
 			definedNodes[sourceNodeKey] = true
 		}
 	}
+	if legend := encoder.legend(); len(legend) > 0 {
+		sb.WriteString("    subgraph legend[\"Legend\"]\n")
+		for i, line := range legend {
+			sb.WriteString(fmt.Sprintf("        legend%d[\"%s\"]\n", i, line))
+		}
+		sb.WriteString("    end\n")
+	}
 
 	// Define edges
 	var edgesSB strings.Builder
@@ -174,8 +141,19 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 			depNodeKey := nodeNames[dep]
 			depID := nodeIDs[depNodeKey]
 			hasEdges = true
-			edgesSB.WriteString(fmt.Sprintf("    %s --> %s\n", sourceID, depID))
 			edgeMD := g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}]
+			arrow := "-->"
+			if edgeMD.Relation != "" {
+				arrow = "-.->"
+			}
+			if label := edgeLabel(edgeMD); label != "" {
+				edgesSB.WriteString(fmt.Sprintf("    %s %s|%s| %s\n", sourceID, arrow, label, depID))
+			} else {
+				edgesSB.WriteString(fmt.Sprintf("    %s %s %s\n", sourceID, arrow, depID))
+			}
+			for _, label := range importLocationLabels(edgeMD) {
+				edgesSB.WriteString(fmt.Sprintf("    %%%% %s\n", label))
+			}
 			if edgeMD.InCycle {
 				cycleEdgeIndices = append(cycleEdgeIndices, edgeIndex)
 			}
@@ -191,7 +169,7 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 	// Count unique file extensions to determine if majority styling is meaningful.
 	uniqueExtensions := make(map[string]bool)
 	for _, source := range filePaths {
-		ext := filepath.Ext(filepath.Base(source))
+		ext := nodeExtension(g, source)
 		uniqueExtensions[ext] = true
 	}
 	hasMultipleExtensions := len(uniqueExtensions) > 1
@@ -208,7 +186,6 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 		}
 	}
 
-	hasStyles := len(testNodes) > 0 || len(majorityExtensionNodes) > 0 || len(cycleNodes) > 0 || len(cycleEdgeIndices) > 0
 	var stylesSB strings.Builder
 
 	// Define style classes
@@ -233,6 +210,24 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 		sourceNodeKey := nodeNames[source]
 		stylesSB.WriteString(fmt.Sprintf("    style %s stroke:#d62728,stroke-width:3px\n", nodeIDs[sourceNodeKey]))
 	}
+	for _, source := range filePaths {
+		var encodedStyles []string
+		if heat, ok := encoder.fillColor(source); ok {
+			encodedStyles = append(encodedStyles, "fill:"+heat)
+		}
+		if width, ok := encoder.penWidth(source); ok {
+			encodedStyles = append(encodedStyles, fmt.Sprintf("stroke-width:%spx", width))
+		}
+		if len(encodedStyles) > 0 {
+			stylesSB.WriteString(fmt.Sprintf("    style %s %s\n", nodeIDs[nodeNames[source]], strings.Join(encodedStyles, ",")))
+		}
+	}
+	for _, source := range filePaths {
+		if !rootNodes[source] {
+			continue
+		}
+		stylesSB.WriteString(fmt.Sprintf("    style %s stroke-width:4px,font-weight:bold\n", nodeIDs[nodeNames[source]]))
+	}
 	for _, idx := range cycleEdgeIndices {
 		stylesSB.WriteString(fmt.Sprintf("    linkStyle %d stroke:#d62728,stroke-width:3px,stroke-dasharray: 5 5\n", idx))
 	}
@@ -241,7 +236,7 @@ func (f mermaidFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOpti
 		sb.WriteString("\n")
 		sb.WriteString(edgesSB.String())
 	}
-	if hasStyles {
+	if stylesSB.Len() > 0 {
 		sb.WriteString("\n")
 		sb.WriteString(stylesSB.String())
 	}
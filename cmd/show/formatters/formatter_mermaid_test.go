@@ -17,6 +17,7 @@ func testFileGraphMermaid(t *testing.T, adjacency map[string][]string, stats map
 	t.Helper()
 	fileGraph, err := depgraph.NewFileDependencyGraph(testGraphMermaid(adjacency), stats, nil)
 	require.NoError(t, err)
+	require.NoError(t, depgraph.AttachCycleBreaks(fileGraph))
 	return fileGraph
 }
 
@@ -370,3 +371,27 @@ func TestMermaidFormatter_DuplicateBaseNamesStayDistinct(t *testing.T) {
 	g := testhelpers.MermaidGoldie(t)
 	g.Assert(t, t.Name(), []byte(output))
 }
+
+func TestMermaidFormatter_GroupedNodesShowEdgeWeights(t *testing.T) {
+	graph := testGroupedFileGraph(t)
+
+	formatter := mermaidFormatter{}
+	output, err := formatter.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.MermaidGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestMermaidFormatter_OutlinesRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := mermaidFormatter{}.Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "    style n0 stroke-width:4px,font-weight:bold")
+	require.NotContains(t, output, "style n1")
+}
@@ -0,0 +1,150 @@
+package formatters
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// plantUMLAlphabet is the base64 variant used by the PlantUML server text encoding.
+const plantUMLAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-_"
+
+// Format converts the dependency graph to a PlantUML diagram.
+func (f plantUMLFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return "", err
+	}
+
+	dir := opts.Direction
+	if dir == "" {
+		dir = DefaultDirection
+	}
+	// PlantUML only lays out left-to-right or top-to-bottom; reversed directions are
+	// produced by declaring edges from the target side.
+	reversed := dir == DirectionRL || dir == DirectionBT
+
+	var sb strings.Builder
+	sb.WriteString("@startuml\n")
+	if opts.Label != "" {
+		sb.WriteString(fmt.Sprintf("title %s\n", opts.Label))
+	}
+	if dir == DirectionLR || dir == DirectionRL {
+		sb.WriteString("left to right direction\n")
+	} else {
+		sb.WriteString("top to bottom direction\n")
+	}
+
+	if summaries := cycleSummaries(g); len(summaries) > 0 {
+		sb.WriteString("\n' Cyclic paths:\n")
+		for _, summary := range summaries {
+			sb.WriteString(fmt.Sprintf("' %s\n", summary))
+		}
+	}
+	if summaries := cycleBreakSummaries(g); len(summaries) > 0 {
+		sb.WriteString("\n' Suggested cycle breaks:\n")
+		for _, summary := range summaries {
+			sb.WriteString(fmt.Sprintf("' %s\n", summary))
+		}
+	}
+	cycleNodes := cycleNodeSet(g)
+	rootNodes := rootNodeSet(opts.Roots)
+
+	filePaths := sortedNodes(adjacency)
+	nodeNames := buildGraphNodeNames(g, filePaths, opts.NodeNames)
+	fillColors := nodeFillColors(g, filePaths)
+
+	nodeIDs := make(map[string]string, len(filePaths))
+	if len(filePaths) > 0 {
+		sb.WriteString("\n")
+	}
+	for i, source := range filePaths {
+		nodeID := fmt.Sprintf("n%d", i)
+		nodeIDs[source] = nodeID
+
+		label := strings.ReplaceAll(nodeLabel(nodeNames[source], g.Meta.Files[source], "\\n"), "\"", "<U+0022>")
+		style := "#" + fillColors[source]
+		if cycleNodes[source] {
+			style += ";line:red;line.bold"
+		}
+		stereotype := ""
+		if rootNodes[source] {
+			stereotype = " <<root>>"
+		}
+		sb.WriteString(fmt.Sprintf("rectangle \"%s\"%s as %s %s\n", label, stereotype, nodeID, style))
+	}
+
+	var edgesSB strings.Builder
+	for _, source := range filePaths {
+		for _, dep := range sortedDependencies(adjacency, source) {
+			edgeMD := g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}]
+
+			line := "--"
+			switch {
+			case edgeMD.BreaksCycle:
+				line = "-[#red,dashed,thickness=3]-"
+			case edgeMD.InCycle:
+				line = "-[#red,dashed]-"
+			case edgeMD.Relation != "":
+				line = ".."
+			}
+			var edge string
+			if reversed {
+				edge = fmt.Sprintf("%s <%s %s", nodeIDs[dep], line, nodeIDs[source])
+			} else {
+				edge = fmt.Sprintf("%s %s> %s", nodeIDs[source], line, nodeIDs[dep])
+			}
+			if label := edgeLabel(edgeMD); label != "" {
+				edge += " : " + label
+			}
+			edgesSB.WriteString(edge + "\n")
+		}
+	}
+	if edgesSB.Len() > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(edgesSB.String())
+	}
+
+	sb.WriteString("@enduml")
+	return sb.String(), nil
+}
+
+// GenerateURL creates a PlantUML server URL with the diagram embedded.
+func (f plantUMLFormatter) GenerateURL(output string) (string, bool) {
+	var compressed bytes.Buffer
+	writer, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return "", false
+	}
+	if _, err := writer.Write([]byte(output)); err != nil {
+		return "", false
+	}
+	if err := writer.Close(); err != nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("https://www.plantuml.com/plantuml/uml/%s", encodePlantUML(compressed.Bytes())), true
+}
+
+// encodePlantUML encodes bytes with the PlantUML base64 alphabet, zero-padding the final group.
+func encodePlantUML(data []byte) string {
+	var sb strings.Builder
+	for i := 0; i < len(data); i += 3 {
+		var b1, b2, b3 byte
+		b1 = data[i]
+		if i+1 < len(data) {
+			b2 = data[i+1]
+		}
+		if i+2 < len(data) {
+			b3 = data[i+2]
+		}
+		sb.WriteByte(plantUMLAlphabet[b1>>2])
+		sb.WriteByte(plantUMLAlphabet[((b1&0x3)<<4)|(b2>>4)])
+		sb.WriteByte(plantUMLAlphabet[((b2&0xF)<<2)|(b3>>6)])
+		sb.WriteByte(plantUMLAlphabet[b3&0x3F])
+	}
+	return sb.String()
+}
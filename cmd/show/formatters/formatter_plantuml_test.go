@@ -0,0 +1,152 @@
+package formatters
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/internal/testhelpers"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlantUMLFormatter_BasicDiagram(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.dart":  {"/project/utils.dart"},
+		"/project/utils.dart": {},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_WithLabel(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go": {"/project/utils.go"},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{Label: "clarity • abc1234 • 2 files"})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_DirectionTB(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go": {"/project/utils.go"},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{Direction: DirectionTB})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_DirectionRL(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go": {"/project/utils.go"},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{Direction: DirectionRL})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_ColorsExtensionsAndTestFiles(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":      {"/project/utils.go", "/project/schema.proto"},
+		"/project/main_test.go": {"/project/main.go"},
+		"/project/utils.go":     {},
+		"/project/schema.proto": {},
+		"/project/web/app.ts":   {},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_HighlightsCycles(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {"/project/a.go"},
+		"/project/c.go": {"/project/a.go"},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_ShowsFileStats(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, map[string]vcs.FileStats{
+		"/project/main.go":  {Additions: 12, Deletions: 3},
+		"/project/utils.go": {Additions: 20, IsNew: true},
+	})
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_GroupedNodesShowEdgeWeights(t *testing.T) {
+	output, err := plantUMLFormatter{}.Format(testGroupedFileGraph(t), RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.PlantUMLGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestPlantUMLFormatter_GenerateURL(t *testing.T) {
+	diagram := "@startuml\nn0 --> n1\n@enduml"
+
+	urlStr, ok := plantUMLFormatter{}.GenerateURL(diagram)
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(urlStr, "https://www.plantuml.com/plantuml/uml/"))
+
+	encoded := strings.TrimPrefix(urlStr, "https://www.plantuml.com/plantuml/uml/")
+	var compressed []byte
+	for i := 0; i+3 < len(encoded); i += 4 {
+		c1 := strings.IndexByte(plantUMLAlphabet, encoded[i])
+		c2 := strings.IndexByte(plantUMLAlphabet, encoded[i+1])
+		c3 := strings.IndexByte(plantUMLAlphabet, encoded[i+2])
+		c4 := strings.IndexByte(plantUMLAlphabet, encoded[i+3])
+		compressed = append(compressed, byte(c1<<2|c2>>4), byte((c2&0xF)<<4|c3>>2), byte((c3&0x3)<<6|c4))
+	}
+
+	// Zero padding in the final group is ignored once the deflate stream ends.
+	decoded, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	require.NoError(t, err)
+	require.Equal(t, diagram, string(decoded))
+}
+
+func TestPlantUMLFormatter_MarksRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":  {"/project/utils.go"},
+		"/project/utils.go": {},
+	}, nil)
+
+	output, err := plantUMLFormatter{}.Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	require.Contains(t, output, "rectangle \"main.go\" <<root>> as n0 #white\n")
+	require.Contains(t, output, "rectangle \"utils.go\" as n1 #white\n")
+}
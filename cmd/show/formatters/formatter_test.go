@@ -24,6 +24,115 @@ func TestNewFormatter_Mermaid(t *testing.T) {
 	}
 }
 
+func TestNewFormatter_PlantUML(t *testing.T) {
+	f, err := NewFormatter("plantuml")
+	if err != nil {
+		t.Fatalf("NewFormatter(plantuml) error = %v", err)
+	}
+
+	if _, ok := f.(plantUMLFormatter); !ok {
+		t.Fatalf("NewFormatter(plantuml) returned %T, want formatters.plantUMLFormatter", f)
+	}
+}
+
+func TestNewFormatter_D2(t *testing.T) {
+	f, err := NewFormatter("d2")
+	if err != nil {
+		t.Fatalf("NewFormatter(d2) error = %v", err)
+	}
+
+	if _, ok := f.(d2Formatter); !ok {
+		t.Fatalf("NewFormatter(d2) returned %T, want formatters.d2Formatter", f)
+	}
+}
+
+func TestNewFormatter_GraphML(t *testing.T) {
+	f, err := NewFormatter("graphml")
+	if err != nil {
+		t.Fatalf("NewFormatter(graphml) error = %v", err)
+	}
+
+	if _, ok := f.(graphMLFormatter); !ok {
+		t.Fatalf("NewFormatter(graphml) returned %T, want formatters.graphMLFormatter", f)
+	}
+}
+
+func TestNewFormatter_GEXF(t *testing.T) {
+	f, err := NewFormatter("gexf")
+	if err != nil {
+		t.Fatalf("NewFormatter(gexf) error = %v", err)
+	}
+
+	if _, ok := f.(gexfFormatter); !ok {
+		t.Fatalf("NewFormatter(gexf) returned %T, want formatters.gexfFormatter", f)
+	}
+}
+
+func TestNewFormatter_SVG(t *testing.T) {
+	f, err := NewFormatter("svg")
+	if err != nil {
+		t.Fatalf("NewFormatter(svg) error = %v", err)
+	}
+
+	if _, ok := f.(svgFormatter); !ok {
+		t.Fatalf("NewFormatter(svg) returned %T, want formatters.svgFormatter", f)
+	}
+}
+
+func TestNewFormatter_PNG(t *testing.T) {
+	f, err := NewFormatter("png")
+	if err != nil {
+		t.Fatalf("NewFormatter(png) error = %v", err)
+	}
+
+	if _, ok := f.(pngFormatter); !ok {
+		t.Fatalf("NewFormatter(png) returned %T, want formatters.pngFormatter", f)
+	}
+}
+
+func TestNewFormatter_DSM(t *testing.T) {
+	tests := map[string]Formatter{
+		"dsm":      dsmFormatter{},
+		"dsm-csv":  dsmCSVFormatter{},
+		"dsm-html": dsmHTMLFormatter{},
+	}
+	for format, want := range tests {
+		f, err := NewFormatter(format)
+		if err != nil {
+			t.Fatalf("NewFormatter(%s) error = %v", format, err)
+		}
+		if f != want {
+			t.Fatalf("NewFormatter(%s) returned %T, want %T", format, f, want)
+		}
+	}
+}
+
+func TestNewFormatter_Text(t *testing.T) {
+	f, err := NewFormatter("text")
+	if err != nil {
+		t.Fatalf("NewFormatter(text) error = %v", err)
+	}
+
+	if _, ok := f.(textFormatter); !ok {
+		t.Fatalf("NewFormatter(text) returned %T, want formatters.textFormatter", f)
+	}
+}
+
+func TestNewFormatter_HTMLRequiresViewerAssets(t *testing.T) {
+	_, err := NewFormatter("html")
+	if err == nil {
+		t.Fatalf("NewFormatter(html) expected error, got nil")
+	}
+}
+
+func TestNewHTMLFormatter(t *testing.T) {
+	f := NewHTMLFormatter(nil)
+
+	if _, ok := f.(htmlFormatter); !ok {
+		t.Fatalf("NewHTMLFormatter() returned %T, want formatters.htmlFormatter", f)
+	}
+}
+
 func TestNewFormatter_UnknownFormat(t *testing.T) {
 	_, err := NewFormatter("unknown")
 	if err == nil {
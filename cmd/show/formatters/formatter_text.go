@@ -0,0 +1,270 @@
+package formatters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// textPathLimit caps the paths listed per pair of --between files.
+const textPathLimit = 20
+
+// textGraph carries the lookups shared by the text layouts.
+type textGraph struct {
+	g          depgraph.FileDependencyGraph
+	adjacency  map[string][]string
+	nodeNames  map[string]string
+	cycleNodes map[string]bool
+}
+
+// Format renders the dependency graph as plain text: a dependency tree for each --file root,
+// the paths between --between files, or the graph layered from dependents down to dependencies.
+func (f textFormatter) Format(g depgraph.FileDependencyGraph, opts RenderOptions) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(g.Graph)
+	if err != nil {
+		return "", err
+	}
+	tg := textGraph{
+		g:          g,
+		adjacency:  adjacency,
+		nodeNames:  buildGraphNodeNames(g, sortedNodes(adjacency), opts.NodeNames),
+		cycleNodes: cycleNodeSet(g),
+	}
+
+	var sb strings.Builder
+	if opts.Label != "" {
+		sb.WriteString(opts.Label + "\n\n")
+	}
+
+	roots := tg.nodesInGraph(opts.Roots)
+	endpoints := tg.nodesInGraph(opts.Endpoints)
+	switch {
+	case len(roots) > 0:
+		for i, root := range roots {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			tg.writeTree(&sb, root)
+		}
+	case len(endpoints) >= 2:
+		tg.writePaths(&sb, endpoints)
+	default:
+		tg.writeLayers(&sb)
+	}
+
+	tg.writeCycles(&sb)
+	tg.writeCycleBreaks(&sb)
+
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// GenerateURL returns false because text output has no online viewer.
+func (f textFormatter) GenerateURL(_ string) (string, bool) {
+	return "", false
+}
+
+func (tg textGraph) nodesInGraph(nodes []string) []string {
+	var present []string
+	for _, node := range nodes {
+		if _, ok := tg.adjacency[node]; ok {
+			present = append(present, node)
+		}
+	}
+	return present
+}
+
+// writeTree prints the dependencies of root as an indented tree. Nodes reached again are not
+// expanded twice, and edges back to an ancestor are marked as cycles.
+func (tg textGraph) writeTree(sb *strings.Builder, root string) {
+	sb.WriteString(tg.nodeLine(root) + "\n")
+
+	expanded := map[string]bool{root: true}
+	ancestors := map[string]bool{root: true}
+
+	var walk func(node, prefix string)
+	walk = func(node, prefix string) {
+		deps := sortedDependencies(tg.adjacency, node)
+		for i, dep := range deps {
+			connector, childPrefix := "├── ", prefix+"│   "
+			if i == len(deps)-1 {
+				connector, childPrefix = "└── ", prefix+"    "
+			}
+
+			// Cyclic nodes are annotated and back-edges end in ↺, so edges only carry their weight.
+			line := prefix + connector + tg.nodeLine(dep) + tg.edgeSuffix(node, dep, false)
+			switch {
+			case ancestors[dep]:
+				sb.WriteString(line + " ↺\n")
+			case expanded[dep] && len(tg.adjacency[dep]) > 0:
+				sb.WriteString(line + " (see above)\n")
+			default:
+				sb.WriteString(line + "\n")
+				expanded[dep] = true
+				ancestors[dep] = true
+				walk(dep, childPrefix)
+				ancestors[dep] = false
+			}
+		}
+	}
+	walk(root, "")
+}
+
+// writePaths lists the dependency paths between every ordered pair of endpoints.
+func (tg textGraph) writePaths(sb *strings.Builder, endpoints []string) {
+	found := false
+	for _, from := range endpoints {
+		for _, to := range endpoints {
+			if from == to {
+				continue
+			}
+			paths, truncated := depgraph.FindSimplePaths(tg.adjacency, from, to, textPathLimit)
+			if len(paths) == 0 {
+				continue
+			}
+			if found {
+				sb.WriteString("\n")
+			}
+			found = true
+
+			sb.WriteString(fmt.Sprintf("Paths from %s to %s:\n", tg.nodeNames[from], tg.nodeNames[to]))
+			for _, path := range paths {
+				names := make([]string, 0, len(path))
+				for _, node := range path {
+					names = append(names, tg.nodeNames[node])
+				}
+				sb.WriteString("  " + strings.Join(names, " -> ") + "\n")
+			}
+			if truncated {
+				sb.WriteString(fmt.Sprintf("  ... more paths omitted (showing the first %d)\n", textPathLimit))
+			}
+		}
+	}
+
+	if !found {
+		names := make([]string, 0, len(endpoints))
+		for _, node := range endpoints {
+			names = append(names, tg.nodeNames[node])
+		}
+		sb.WriteString(fmt.Sprintf("No paths between %s\n", strings.Join(names, ", ")))
+	}
+}
+
+// writeLayers prints the graph top-down by dependency layer, so every file appears above the
+// files it depends on, except for edges inside a cycle.
+func (tg textGraph) writeLayers(sb *strings.Builder) {
+	layers := depgraph.DependencyLayers(tg.adjacency)
+	for i := len(layers) - 1; i >= 0; i-- {
+		sb.WriteString(fmt.Sprintf("Layer %d\n", i))
+		for _, node := range layers[i].Nodes() {
+			line := "  " + tg.nodeLine(node)
+			deps := sortedDependencies(tg.adjacency, node)
+			if len(deps) > 0 {
+				depNames := make([]string, 0, len(deps))
+				for _, dep := range deps {
+					depNames = append(depNames, tg.nodeNames[dep]+tg.edgeSuffix(node, dep, true))
+				}
+				line += " -> " + strings.Join(depNames, ", ")
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+}
+
+func (tg textGraph) writeCycles(sb *strings.Builder) {
+	var lines []string
+	for i, cycle := range tg.g.Meta.Cycles {
+		if len(cycle.Path) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(cycle.Path)+1)
+		for _, node := range cycle.Path {
+			names = append(names, tg.nodeNames[node])
+		}
+		names = append(names, tg.nodeNames[cycle.Path[0]])
+		lines = append(lines, fmt.Sprintf("  C%d: %s\n", i+1, strings.Join(names, " -> ")))
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	sb.WriteString("\nCycles:\n")
+	for _, line := range lines {
+		sb.WriteString(line)
+	}
+}
+
+// writeCycleBreaks lists the edges suggested to break each cycle, most shared first.
+func (tg textGraph) writeCycleBreaks(sb *strings.Builder) {
+	var lines []string
+	for i, cycle := range tg.g.Meta.Cycles {
+		for _, cycleBreak := range cycle.Breaks {
+			lines = append(lines, fmt.Sprintf("  C%d: %s -> %s (%s)\n", i+1,
+				tg.nodeNames[cycleBreak.Edge.From], tg.nodeNames[cycleBreak.Edge.To], depgraph.CycleCountLabel(cycleBreak.Cycles)))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	sb.WriteString("\nSuggested cycle breaks:\n")
+	for _, line := range lines {
+		sb.WriteString(line)
+	}
+}
+
+// nodeLine renders a node name followed by its annotations, e.g. "main.go [new, +12 -3]".
+func (tg textGraph) nodeLine(node string) string {
+	md := tg.g.Meta.Files[node]
+
+	var annotations []string
+	if len(md.Members) > 0 {
+		annotations = append(annotations, memberCountLabel(len(md.Members)))
+	}
+	if md.IsTest {
+		annotations = append(annotations, "test")
+	}
+	if md.Stats != nil {
+		if md.Stats.IsNew {
+			annotations = append(annotations, "new")
+		}
+		var statsParts []string
+		if md.Stats.Additions > 0 {
+			statsParts = append(statsParts, fmt.Sprintf("+%d", md.Stats.Additions))
+		}
+		if md.Stats.Deletions > 0 {
+			statsParts = append(statsParts, fmt.Sprintf("-%d", md.Stats.Deletions))
+		}
+		if len(statsParts) > 0 {
+			annotations = append(annotations, strings.Join(statsParts, " "))
+		}
+	}
+	if tg.cycleNodes[node] {
+		annotations = append(annotations, "cycle")
+	}
+
+	if len(annotations) == 0 {
+		return tg.nodeNames[node]
+	}
+	return fmt.Sprintf("%s [%s]", tg.nodeNames[node], strings.Join(annotations, ", "))
+}
+
+// edgeSuffix renders the edge weight and, when markCycle is set, the cycle marker, e.g. " (x3, cycle)".
+func (tg textGraph) edgeSuffix(from, to string, markCycle bool) string {
+	edgeMD := tg.g.Meta.Edges[depgraph.FileEdge{From: from, To: to}]
+
+	var parts []string
+	if edgeMD.Weight > 0 {
+		parts = append(parts, fmt.Sprintf("x%d", edgeMD.Weight))
+	}
+	if markCycle && edgeMD.InCycle {
+		parts = append(parts, "cycle")
+	}
+	if relation := edgeRelationLabel(edgeMD); relation != "" {
+		parts = append(parts, relation)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(parts, ", "))
+}
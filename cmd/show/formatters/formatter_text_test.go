@@ -0,0 +1,72 @@
+package formatters
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/internal/testhelpers"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextFormatter_LayersGraphWithCyclesAndStats(t *testing.T) {
+	graph := testFileGraph(t, testLayeredFileGraph(t), map[string]vcs.FileStats{
+		"/project/main.go":   {Additions: 12, Deletions: 3},
+		"/project/config.go": {Additions: 8, IsNew: true},
+	})
+
+	output, err := textFormatter{}.Format(graph, RenderOptions{Label: "clarity • abc1234 • 5 files"})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestTextFormatter_GroupedGraphShowsEdgeWeights(t *testing.T) {
+	output, err := textFormatter{}.Format(testGroupedFileGraph(t), RenderOptions{})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestTextFormatter_PrintsTreeForRoots(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":   {"/project/server.go", "/project/config.go"},
+		"/project/server.go": {"/project/store.go", "/project/config.go"},
+		"/project/store.go":  {"/project/server.go"},
+		"/project/config.go": {"/project/env.go"},
+		"/project/env.go":    {},
+	}, nil)
+
+	output, err := textFormatter{}.Format(graph, RenderOptions{Roots: []string{"/project/main.go"}})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestTextFormatter_ListsPathsBetweenEndpoints(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/main.go":   {"/project/server.go", "/project/config.go"},
+		"/project/server.go": {"/project/store.go"},
+		"/project/config.go": {"/project/store.go"},
+		"/project/store.go":  {},
+	}, nil)
+
+	output, err := textFormatter{}.Format(graph, RenderOptions{Endpoints: []string{"/project/main.go", "/project/store.go"}})
+	require.NoError(t, err)
+
+	g := testhelpers.TextGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
+
+func TestTextFormatter_ReportsMissingPaths(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/a.go": {},
+		"/project/b.go": {},
+	}, nil)
+
+	output, err := textFormatter{}.Format(graph, RenderOptions{Endpoints: []string{"/project/a.go", "/project/b.go"}})
+	require.NoError(t, err)
+	require.Equal(t, "No paths between a.go, b.go", output)
+}
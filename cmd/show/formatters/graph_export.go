@@ -0,0 +1,102 @@
+package formatters
+
+import (
+	"fmt"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// exportNode is a graph node flattened with its metadata for data-exchange formats.
+type exportNode struct {
+	ID        string
+	Name      string
+	Path      string
+	Extension string
+	IsTest    bool
+	Additions int
+	Deletions int
+	IsNew     bool
+	InCycle   bool
+	Members   int
+	IsRoot    bool
+}
+
+// exportEdge is a graph edge flattened with its metadata for data-exchange formats.
+// Weight is 1 for file edges and the collapsed file edge count for grouped edges.
+// Relation is "import" unless the edge is tagged otherwise, e.g. "generated-from", and Imports
+// lists the located import statements behind the edge.
+type exportEdge struct {
+	ID          string
+	Source      string
+	Target      string
+	InCycle     bool
+	BreaksCycle bool
+	Weight      int
+	Relation    string
+	Imports     []string
+}
+
+// exportNodes returns the graph nodes in sorted path order with stable n<index> IDs.
+func exportNodes(g depgraph.FileDependencyGraph, adjacency map[string][]string, opts RenderOptions) []exportNode {
+	paths := sortedNodes(adjacency)
+	names := buildGraphNodeNames(g, paths, opts.NodeNames)
+	cycleNodes := cycleNodeSet(g)
+	rootNodes := rootNodeSet(opts.Roots)
+
+	nodes := make([]exportNode, 0, len(paths))
+	for i, path := range paths {
+		md := g.Meta.Files[path]
+		node := exportNode{
+			ID:        fmt.Sprintf("n%d", i),
+			Name:      names[path],
+			Path:      path,
+			Extension: nodeExtension(g, path),
+			IsTest:    md.IsTest,
+			InCycle:   cycleNodes[path],
+			Members:   len(md.Members),
+			IsRoot:    rootNodes[path],
+		}
+		if md.Stats != nil {
+			node.Additions = md.Stats.Additions
+			node.Deletions = md.Stats.Deletions
+			node.IsNew = md.Stats.IsNew
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// exportEdges returns the graph edges in sorted order, referencing the IDs from exportNodes.
+func exportEdges(g depgraph.FileDependencyGraph, adjacency map[string][]string) []exportEdge {
+	paths := sortedNodes(adjacency)
+	ids := make(map[string]string, len(paths))
+	for i, path := range paths {
+		ids[path] = fmt.Sprintf("n%d", i)
+	}
+
+	var edges []exportEdge
+	for _, source := range paths {
+		for _, dep := range sortedDependencies(adjacency, source) {
+			md := g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}]
+			weight := md.Weight
+			if weight == 0 {
+				weight = 1
+			}
+			relation := md.Relation
+			if relation == "" {
+				relation = "import"
+			}
+			edges = append(edges, exportEdge{
+				ID:          fmt.Sprintf("e%d", len(edges)),
+				Source:      ids[source],
+				Target:      ids[dep],
+				InCycle:     md.InCycle,
+				BreaksCycle: md.BreaksCycle,
+				Weight:      weight,
+				Relation:    relation,
+				Imports:     importLocationLabels(md),
+			})
+		}
+	}
+	return edges
+}
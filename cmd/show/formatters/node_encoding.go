@@ -0,0 +1,163 @@
+package formatters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// NodeEncoding is an optional visual encoding of a per-node metric.
+type NodeEncoding string
+
+const (
+	// EncodingSize scales node border width by churn.
+	EncodingSize NodeEncoding = "size"
+	// EncodingHeatmap shades node fill by net lines added (green) or removed (red).
+	EncodingHeatmap NodeEncoding = "heatmap"
+)
+
+// ParseNodeEncoding converts a string to NodeEncoding.
+func ParseNodeEncoding(s string) (NodeEncoding, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "size":
+		return EncodingSize, true
+	case "heatmap":
+		return EncodingHeatmap, true
+	default:
+		return "", false
+	}
+}
+
+// SupportedNodeEncodings returns a list of all supported encoding names.
+func SupportedNodeEncodings() string {
+	return "size, heatmap"
+}
+
+// NodeMetric is the per-node value that visual encodings scale by.
+type NodeMetric struct {
+	// Churn drives node size, e.g. lines added plus deleted or commits touching the file.
+	Churn int
+	// Net drives heatmap fill: lines added minus lines deleted.
+	Net int
+}
+
+const (
+	minEncodedPenWidth = 1.0
+	maxEncodedPenWidth = 6.0
+)
+
+// nodeEncoder applies RenderOptions.Encodings to individual nodes.
+type nodeEncoder struct {
+	size     bool
+	heatmap  bool
+	metrics  map[string]NodeMetric
+	label    string
+	window   string
+	maxChurn int
+	maxNet   int
+}
+
+// newNodeEncoder prepares the encodings for g. Metrics default to the change stats of each node
+// when opts.Metrics is nil.
+func newNodeEncoder(g depgraph.FileDependencyGraph, nodes []string, opts RenderOptions) nodeEncoder {
+	encoder := nodeEncoder{metrics: opts.Metrics, label: opts.MetricLabel, window: opts.MetricWindow}
+	for _, encoding := range opts.Encodings {
+		switch encoding {
+		case EncodingSize:
+			encoder.size = true
+		case EncodingHeatmap:
+			encoder.heatmap = true
+		}
+	}
+	if encoder.metrics == nil {
+		encoder.metrics = changeMetrics(g)
+	}
+	if encoder.label == "" {
+		encoder.label = "lines changed"
+	}
+	if encoder.window == "" {
+		encoder.window = "changed"
+	}
+
+	for _, node := range nodes {
+		metric := encoder.metrics[node]
+		encoder.maxChurn = max(encoder.maxChurn, metric.Churn)
+		encoder.maxNet = max(encoder.maxNet, abs(metric.Net))
+	}
+	return encoder
+}
+
+// changeMetrics derives node metrics from the additions and deletions of the change being shown.
+func changeMetrics(g depgraph.FileDependencyGraph) map[string]NodeMetric {
+	metrics := make(map[string]NodeMetric, len(g.Meta.Files))
+	for path, md := range g.Meta.Files {
+		if md.Stats == nil {
+			continue
+		}
+		metrics[path] = NodeMetric{
+			Churn: md.Stats.Additions + md.Stats.Deletions,
+			Net:   md.Stats.Additions - md.Stats.Deletions,
+		}
+	}
+	return metrics
+}
+
+func (e nodeEncoder) enabled() bool {
+	return e.size || e.heatmap
+}
+
+// penWidth scales a node border between minEncodedPenWidth and maxEncodedPenWidth by churn.
+// ok is false when size encoding is off or the node has no churn.
+func (e nodeEncoder) penWidth(node string) (string, bool) {
+	churn := e.metrics[node].Churn
+	if !e.size || churn == 0 || e.maxChurn == 0 {
+		return "", false
+	}
+	width := minEncodedPenWidth + (maxEncodedPenWidth-minEncodedPenWidth)*float64(churn)/float64(e.maxChurn)
+	return fmt.Sprintf("%.1f", width), true
+}
+
+// fillColor shades white towards green for net additions and towards red for net deletions.
+// ok is false when heatmap encoding is off or the node has no net change.
+func (e nodeEncoder) fillColor(node string) (string, bool) {
+	net := e.metrics[node].Net
+	if !e.heatmap || net == 0 || e.maxNet == 0 {
+		return "", false
+	}
+	intensity := float64(abs(net)) / float64(e.maxNet)
+	if net > 0 {
+		return blendFromWhite(0x2c, 0xa0, 0x2c, intensity), true
+	}
+	return blendFromWhite(0xd6, 0x27, 0x28, intensity), true
+}
+
+// legend describes the active encodings, one line each.
+func (e nodeEncoder) legend() []string {
+	var lines []string
+	if e.size {
+		lines = append(lines, fmt.Sprintf("Border width: %s (max %d)", e.label, e.maxChurn))
+	}
+	if e.heatmap {
+		lines = append(lines, fmt.Sprintf("Fill: net lines %s, green adds, red removes (max ±%d)", e.window, e.maxNet))
+	}
+	return lines
+}
+
+// blendFromWhite mixes white with the given color, keeping a light tint for small values so
+// labels stay readable.
+func blendFromWhite(r, g, b int, intensity float64) string {
+	const minIntensity = 0.15
+	intensity = minIntensity + (1-minIntensity)*intensity
+	mix := func(channel int) int {
+		return 255 - int(float64(255-channel)*intensity+0.5)
+	}
+	return fmt.Sprintf("#%02X%02X%02X", mix(r), mix(g), mix(b))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,106 @@
+package formatters
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeEncoding(t *testing.T) {
+	encoding, ok := ParseNodeEncoding(" Heatmap ")
+	assert.True(t, ok)
+	assert.Equal(t, EncodingHeatmap, encoding)
+
+	_, ok = ParseNodeEncoding("color")
+	assert.False(t, ok)
+}
+
+func TestDependencyGraph_ToDOT_EncodesChangeStats(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/big.go":     {"/project/small.go"},
+		"/project/small.go":   {"/project/removed.go"},
+		"/project/removed.go": {},
+		"/project/plain.go":   {},
+	}, map[string]vcs.FileStats{
+		"/project/big.go":     {Additions: 40},
+		"/project/small.go":   {Additions: 6, Deletions: 2},
+		"/project/removed.go": {Deletions: 20},
+	})
+
+	output, err := dotFormatter{}.Format(graph, RenderOptions{Encodings: []NodeEncoding{EncodingSize, EncodingHeatmap}})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, `"big.go" [label="big.go\n+40", style=filled, fillcolor="#2CA02C", penwidth=6.0];`)
+	assert.Contains(t, output, `"small.go" [label="small.go\n+6 -2", style=filled, fillcolor="#CDE9CD", penwidth=2.0];`)
+	assert.Contains(t, output, `"removed.go" [label="removed.go\n-20", style=filled, fillcolor="#E78383", penwidth=3.5];`)
+	assert.Contains(t, output, `"plain.go" [label="plain.go", style=filled, fillcolor=white];`)
+	assert.Contains(t, output, "  subgraph cluster_legend {\n    label=\"Legend\";\n    fontsize=10;\n"+
+		`    "__legend__" [shape=plaintext, label="Border width: lines changed (max 40)\nFill: net lines changed, green adds, red removes (max ±40)"];`+"\n  }\n}")
+}
+
+func TestDependencyGraph_ToDOT_EncodesSuppliedMetrics(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/hot.go":  {"/project/cold.go"},
+		"/project/cold.go": {},
+	}, nil)
+
+	output, err := dotFormatter{}.Format(graph, RenderOptions{
+		Encodings:   []NodeEncoding{EncodingSize},
+		Metrics:     map[string]NodeMetric{"/project/hot.go": {Churn: 12}, "/project/cold.go": {Churn: 3}},
+		MetricLabel: "commits in history",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, `"hot.go" [label="hot.go", style=filled, fillcolor=white, penwidth=6.0];`)
+	assert.Contains(t, output, `"cold.go" [label="cold.go", style=filled, fillcolor=white, penwidth=2.2];`)
+	assert.Contains(t, output, `label="Border width: commits in history (max 12)"`)
+	assert.NotContains(t, output, "Fill:")
+}
+
+func TestDependencyGraph_ToDOT_HeatmapLegendNamesHistoryWindow(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/hot.go":  {"/project/cold.go"},
+		"/project/cold.go": {},
+	}, nil)
+
+	output, err := dotFormatter{}.Format(graph, RenderOptions{
+		Encodings:    []NodeEncoding{EncodingSize, EncodingHeatmap},
+		Metrics:      map[string]NodeMetric{"/project/hot.go": {Churn: 12, Net: 30}, "/project/cold.go": {Churn: 3, Net: -10}},
+		MetricLabel:  "commits since 1 year ago",
+		MetricWindow: "since 1 year ago",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, `label="Border width: commits since 1 year ago (max 12)\nFill: net lines since 1 year ago, green adds, red removes (max ±30)"`)
+}
+
+func TestDependencyGraph_ToDOT_WithoutEncodingsHasNoLegend(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{"/project/a.go": {}}, map[string]vcs.FileStats{
+		"/project/a.go": {Additions: 3},
+	})
+
+	output, err := dotFormatter{}.Format(graph, RenderOptions{})
+	require.NoError(t, err)
+
+	assert.NotContains(t, output, "penwidth")
+	assert.NotContains(t, output, "cluster_legend")
+}
+
+func TestMermaidFormatter_EncodesChangeStats(t *testing.T) {
+	graph := testFileGraph(t, map[string][]string{
+		"/project/big.go":   {"/project/small.go"},
+		"/project/small.go": {},
+	}, map[string]vcs.FileStats{
+		"/project/big.go":   {Additions: 10},
+		"/project/small.go": {Deletions: 5},
+	})
+
+	output, err := mermaidFormatter{}.Format(graph, RenderOptions{Encodings: []NodeEncoding{EncodingSize, EncodingHeatmap}})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "    subgraph legend[\"Legend\"]\n        legend0[\"Border width: lines changed (max 10)\"]\n        legend1[\"Fill: net lines changed, green adds, red removes (max ±10)\"]\n    end\n")
+	assert.Contains(t, output, "    style n0 fill:#2CA02C,stroke-width:6.0px\n")
+	assert.Contains(t, output, "    style n1 fill:#E78383,stroke-width:3.5px")
+}
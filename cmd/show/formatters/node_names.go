@@ -1,8 +1,11 @@
 package formatters
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
 )
 
 // BuildNodeNames returns stable, distinct display names for file paths.
@@ -60,3 +63,52 @@ func pathSuffix(path string, depth int) string {
 	}
 	return strings.Join(parts[len(parts)-depth:], "/")
 }
+
+// buildGraphNodeNames returns display names for graph nodes. Names in overrides win, grouped
+// nodes keep their group key, which is already a display name, and file nodes go through BuildNodeNames.
+func buildGraphNodeNames(g depgraph.FileDependencyGraph, paths []string, overrides map[string]string) map[string]string {
+	var filePaths []string
+	names := make(map[string]string, len(paths))
+	for _, path := range paths {
+		if name, ok := overrides[path]; ok {
+			names[path] = name
+			continue
+		}
+		if md, ok := g.Meta.Files[path]; ok && len(md.Members) > 0 {
+			names[path] = path
+			continue
+		}
+		filePaths = append(filePaths, path)
+	}
+
+	for path, name := range BuildNodeNames(filePaths) {
+		names[path] = name
+	}
+	return names
+}
+
+// nodeExtension returns the extension used to color a node, preferring file metadata so that
+// grouped nodes take the majority extension of their members.
+func nodeExtension(g depgraph.FileDependencyGraph, path string) string {
+	if md, ok := g.Meta.Files[path]; ok && (md.Extension != "" || len(md.Members) > 0) {
+		return md.Extension
+	}
+	return filepath.Ext(filepath.Base(path))
+}
+
+// nodeExtensionNames returns one name per node carrying the node's extension,
+// suitable for getExtensionColors.
+func nodeExtensionNames(g depgraph.FileDependencyGraph, paths []string) []string {
+	names := make([]string, 0, len(paths))
+	for _, path := range paths {
+		names = append(names, "node"+nodeExtension(g, path))
+	}
+	return names
+}
+
+func memberCountLabel(count int) string {
+	if count == 1 {
+		return "1 file"
+	}
+	return fmt.Sprintf("%d files", count)
+}
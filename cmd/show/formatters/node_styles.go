@@ -0,0 +1,191 @@
+package formatters
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+// nodeFillColors returns the fill color of every node: test files are light green, files with the
+// majority extension are white, and the remaining files are colored by extension when the graph
+// mixes extensions.
+func nodeFillColors(g depgraph.FileDependencyGraph, filePaths []string) map[string]string {
+	extensionColors := getExtensionColors(nodeExtensionNames(g, filePaths))
+
+	// Count files by extension to find the majority extension
+	extensionCounts := make(map[string]int)
+	for _, source := range filePaths {
+		extensionCounts[nodeExtension(g, source)]++
+	}
+
+	// Sort extensions for deterministic selection when counts are tied
+	sortedExtensions := make([]string, 0, len(extensionCounts))
+	for ext := range extensionCounts {
+		sortedExtensions = append(sortedExtensions, ext)
+	}
+	sort.Strings(sortedExtensions)
+
+	maxCount := 0
+	majorityExtension := ""
+	for _, ext := range sortedExtensions {
+		count := extensionCounts[ext]
+		if count > maxCount {
+			maxCount = count
+			majorityExtension = ext
+		}
+	}
+	hasMultipleExtensions := len(extensionCounts) > 1
+
+	colors := make(map[string]string, len(filePaths))
+	for _, source := range filePaths {
+		ext := nodeExtension(g, source)
+		fileMetadata, hasFileMetadata := g.Meta.Files[source]
+
+		switch {
+		case hasFileMetadata && fileMetadata.IsTest:
+			// Priority 1: Test files are always light green
+			colors[source] = "lightgreen"
+		case ext == majorityExtension:
+			// Priority 2: Files with majority extension count are always white
+			colors[source] = "white"
+		case hasMultipleExtensions:
+			// Priority 3: Color based on extension (only if multiple extensions exist)
+			if color, ok := extensionColors[ext]; ok {
+				colors[source] = color
+			} else {
+				// If extension not found (e.g., empty extension), return white as default
+				colors[source] = "white"
+			}
+		default:
+			// Priority 4: Single extension - use white (no need to differentiate)
+			colors[source] = "white"
+		}
+	}
+
+	return colors
+}
+
+// nodeLabel builds a node label from its display name, group size and change stats,
+// joining lines with the format-specific lineBreak.
+func nodeLabel(name string, fileMetadata depgraph.FileMetadata, lineBreak string) string {
+	label := name
+	if len(fileMetadata.Members) > 0 {
+		label = fmt.Sprintf("%s%s%s", label, lineBreak, memberCountLabel(len(fileMetadata.Members)))
+	}
+	if fileMetadata.Stats == nil {
+		return label
+	}
+
+	stats := *fileMetadata.Stats
+	if stats.IsNew {
+		label = fmt.Sprintf("🪴 %s", label)
+	}
+
+	var statsParts []string
+	if stats.Additions > 0 {
+		statsParts = append(statsParts, fmt.Sprintf("+%d", stats.Additions))
+	}
+	if stats.Deletions > 0 {
+		statsParts = append(statsParts, fmt.Sprintf("-%d", stats.Deletions))
+	}
+	if len(statsParts) > 0 {
+		label = fmt.Sprintf("%s%s%s", label, lineBreak, strings.Join(statsParts, " "))
+	}
+
+	return label
+}
+
+// cycleNodeSet returns every node that lies on a cyclic path or a cyclic edge.
+func cycleNodeSet(g depgraph.FileDependencyGraph) map[string]bool {
+	cycleNodes := make(map[string]bool)
+	for _, cycle := range g.Meta.Cycles {
+		for _, node := range cycle.Path {
+			cycleNodes[node] = true
+		}
+	}
+	for edge, md := range g.Meta.Edges {
+		if !md.InCycle {
+			continue
+		}
+		cycleNodes[edge.From] = true
+		cycleNodes[edge.To] = true
+	}
+	return cycleNodes
+}
+
+// rootNodeSet returns the --file targets, which graph formats outline.
+func rootNodeSet(roots []string) map[string]bool {
+	rootNodes := make(map[string]bool, len(roots))
+	for _, root := range roots {
+		rootNodes[root] = true
+	}
+	return rootNodes
+}
+
+// cycleSummaries describes each cyclic path as "C<n>: a -> b -> a" using base names.
+func cycleSummaries(g depgraph.FileDependencyGraph) []string {
+	var summaries []string
+	for i, cycle := range g.Meta.Cycles {
+		if len(cycle.Path) == 0 {
+			continue
+		}
+		var cycleParts []string
+		for _, node := range cycle.Path {
+			cycleParts = append(cycleParts, filepath.Base(node))
+		}
+		cycleParts = append(cycleParts, filepath.Base(cycle.Path[0]))
+		summaries = append(summaries, fmt.Sprintf("C%d: %s", i+1, strings.Join(cycleParts, " -> ")))
+	}
+	return summaries
+}
+
+// cycleBreakSummaries lists the suggested cycle breaks as "C<n>: a -> b (2 cycles)" using base names,
+// numbered like cycleSummaries.
+func cycleBreakSummaries(g depgraph.FileDependencyGraph) []string {
+	var summaries []string
+	for i, cycle := range g.Meta.Cycles {
+		for _, cycleBreak := range cycle.Breaks {
+			summaries = append(summaries, fmt.Sprintf("C%d: %s -> %s (%s)", i+1,
+				filepath.Base(cycleBreak.Edge.From), filepath.Base(cycleBreak.Edge.To), depgraph.CycleCountLabel(cycleBreak.Cycles)))
+		}
+	}
+	return summaries
+}
+
+// sortedNodes returns the graph's nodes in deterministic order.
+func sortedNodes(adjacency map[string][]string) []string {
+	nodes := make([]string, 0, len(adjacency))
+	for node := range adjacency {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// sortedDependencies returns a sorted copy of a node's dependencies.
+func sortedDependencies(adjacency map[string][]string, node string) []string {
+	deps := append([]string(nil), adjacency[node]...)
+	sort.Strings(deps)
+	return deps
+}
+
+// edgeRelationLabel describes an edge that is not an import, e.g. "generated from", and is empty
+// for imports.
+func edgeRelationLabel(md depgraph.EdgeMetadata) string {
+	return strings.ReplaceAll(md.Relation, "-", " ")
+}
+
+// edgeLabel combines the weight of a grouped edge with its relation, e.g. "3 generated from".
+func edgeLabel(md depgraph.EdgeMetadata) string {
+	var parts []string
+	if md.Weight > 0 {
+		parts = append(parts, fmt.Sprintf("%d", md.Weight))
+	}
+	if relation := edgeRelationLabel(md); relation != "" {
+		parts = append(parts, relation)
+	}
+	return strings.Join(parts, " ")
+}
@@ -8,6 +8,17 @@ type OutputFormat int
 const (
 	OutputFormatDOT OutputFormat = iota
 	OutputFormatMermaid
+	OutputFormatPlantUML
+	OutputFormatD2
+	OutputFormatGraphML
+	OutputFormatGEXF
+	OutputFormatHTML
+	OutputFormatSVG
+	OutputFormatPNG
+	OutputFormatDSM
+	OutputFormatDSMCSV
+	OutputFormatDSMHTML
+	OutputFormatText
 	endOfSupportedFormatsMarker // endOfSupportedFormatsMarker for iteration
 )
 
@@ -18,6 +29,28 @@ func (f OutputFormat) String() string {
 		return "dot"
 	case OutputFormatMermaid:
 		return "mermaid"
+	case OutputFormatPlantUML:
+		return "plantuml"
+	case OutputFormatD2:
+		return "d2"
+	case OutputFormatGraphML:
+		return "graphml"
+	case OutputFormatGEXF:
+		return "gexf"
+	case OutputFormatHTML:
+		return "html"
+	case OutputFormatSVG:
+		return "svg"
+	case OutputFormatPNG:
+		return "png"
+	case OutputFormatDSM:
+		return "dsm"
+	case OutputFormatDSMCSV:
+		return "dsm-csv"
+	case OutputFormatDSMHTML:
+		return "dsm-html"
+	case OutputFormatText:
+		return "text"
 	case endOfSupportedFormatsMarker:
 		return "unknown"
 	default:
@@ -32,6 +65,28 @@ func ParseOutputFormat(s string) (OutputFormat, bool) {
 		return OutputFormatDOT, true
 	case "mermaid":
 		return OutputFormatMermaid, true
+	case "plantuml":
+		return OutputFormatPlantUML, true
+	case "d2":
+		return OutputFormatD2, true
+	case "graphml":
+		return OutputFormatGraphML, true
+	case "gexf":
+		return OutputFormatGEXF, true
+	case "html":
+		return OutputFormatHTML, true
+	case "svg":
+		return OutputFormatSVG, true
+	case "png":
+		return OutputFormatPNG, true
+	case "dsm":
+		return OutputFormatDSM, true
+	case "dsm-csv":
+		return OutputFormatDSMCSV, true
+	case "dsm-html":
+		return OutputFormatDSMHTML, true
+	case "text":
+		return OutputFormatText, true
 	default:
 		return OutputFormatDOT, false
 	}
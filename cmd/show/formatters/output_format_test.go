@@ -9,6 +9,17 @@ func TestOutputFormat_String(t *testing.T) {
 	}{
 		{OutputFormatDOT, "dot"},
 		{OutputFormatMermaid, "mermaid"},
+		{OutputFormatPlantUML, "plantuml"},
+		{OutputFormatD2, "d2"},
+		{OutputFormatGraphML, "graphml"},
+		{OutputFormatGEXF, "gexf"},
+		{OutputFormatHTML, "html"},
+		{OutputFormatSVG, "svg"},
+		{OutputFormatPNG, "png"},
+		{OutputFormatDSM, "dsm"},
+		{OutputFormatDSMCSV, "dsm-csv"},
+		{OutputFormatDSMHTML, "dsm-html"},
+		{OutputFormatText, "text"},
 		{endOfSupportedFormatsMarker, "unknown"},
 		{OutputFormat(99), "unknown"},
 	}
@@ -30,6 +41,18 @@ func TestParseOutputFormat(t *testing.T) {
 	}{
 		{"dot", OutputFormatDOT, true},
 		{"mermaid", OutputFormatMermaid, true},
+		{"plantuml", OutputFormatPlantUML, true},
+		{"d2", OutputFormatD2, true},
+		{"graphml", OutputFormatGraphML, true},
+		{"gexf", OutputFormatGEXF, true},
+		{"html", OutputFormatHTML, true},
+		{"svg", OutputFormatSVG, true},
+		{"png", OutputFormatPNG, true},
+		{"dsm", OutputFormatDSM, true},
+		{"dsm-csv", OutputFormatDSMCSV, true},
+		{"DSM-HTML", OutputFormatDSMHTML, true},
+		{"text", OutputFormatText, true},
+		{"GraphML", OutputFormatGraphML, true}, // case-insensitive
 		{"invalid", OutputFormatDOT, false},
 		{"", OutputFormatDOT, false},
 		{"DOT", OutputFormatDOT, true},         // case-insensitive
@@ -48,7 +71,7 @@ func TestParseOutputFormat(t *testing.T) {
 
 func TestSupportedFormats(t *testing.T) {
 	got := SupportedFormats()
-	expected := "dot, mermaid"
+	expected := "dot, mermaid, plantuml, d2, graphml, gexf, html, svg, png, dsm, dsm-csv, dsm-html, text"
 
 	if got != expected {
 		t.Errorf("SupportedFormats() = %q, want %q", got, expected)
@@ -57,7 +80,7 @@ func TestSupportedFormats(t *testing.T) {
 
 func TestSupportedFormatsCount(t *testing.T) {
 	// Verify the count matches the number of formats
-	expectedCount := 2
+	expectedCount := 13
 	if int(endOfSupportedFormatsMarker) != expectedCount {
 		t.Errorf("endOfSupportedFormatsMarker = %d, want %d", endOfSupportedFormatsMarker, expectedCount)
 	}
@@ -0,0 +1,172 @@
+package show
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+const (
+	groupByDir     = "dir"
+	groupByPackage = "package"
+	groupByModule  = "module"
+)
+
+// moduleManifests are the build files that mark the root of a module for --group-by module.
+var moduleManifests = []string{
+	"go.mod",
+	"package.json",
+	"pom.xml",
+	"build.gradle",
+	"build.gradle.kts",
+	"build.sbt",
+	"Cargo.toml",
+	"pyproject.toml",
+	"setup.py",
+	"composer.json",
+	"mix.exs",
+	"rebar.config",
+	"Package.swift",
+	"pubspec.yaml",
+}
+
+func supportedGroupBys() string {
+	return strings.Join([]string{groupByDir, groupByPackage, groupByModule}, ", ")
+}
+
+func normalizeGroupBy(groupBy string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(groupBy))
+	switch normalized {
+	case "", groupByDir, groupByPackage, groupByModule:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("unknown group-by: %s (valid options: %s)", groupBy, supportedGroupBys())
+	}
+}
+
+// groupKeyFunc returns the group each file collapses into under --group-by, or nil without it.
+// packages holds the declared package of each JVM file, as recorded while building the graph.
+func groupKeyFunc(opts *graphOptions, packages map[string]string, contentReader vcs.ContentReader) func(filePath string) string {
+	switch opts.groupBy {
+	case groupByDir:
+		return func(filePath string) string {
+			return dirGroupKey(opts.repoPath, filepath.Dir(filePath))
+		}
+	case groupByPackage:
+		return packageGroupKeys(opts.repoPath, packages)
+	case groupByModule:
+		return moduleGroupKeys(opts.repoPath, contentReader)
+	default:
+		return nil
+	}
+}
+
+// applyGroupBy collapses the file graph into directory, package or module nodes.
+func applyGroupBy(opts *graphOptions, fileGraph depgraph.FileDependencyGraph, groupOf func(filePath string) string) (depgraph.FileDependencyGraph, error) {
+	if groupOf == nil {
+		return fileGraph, nil
+	}
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, groupOf)
+	if err != nil {
+		return depgraph.FileDependencyGraph{}, fmt.Errorf("failed to group graph by %s: %w", opts.groupBy, err)
+	}
+	return grouped, nil
+}
+
+// groupNodes maps files such as --file roots and --between endpoints to the groups they
+// collapsed into, keeping the first occurrence of each group.
+func groupNodes(filePaths []string, groupOf func(filePath string) string) []string {
+	if groupOf == nil || filePaths == nil {
+		return filePaths
+	}
+
+	seen := make(map[string]bool, len(filePaths))
+	groups := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		key := groupOf(filePath)
+		if key == "" {
+			key = filePath
+		}
+		if !seen[key] {
+			seen[key] = true
+			groups = append(groups, key)
+		}
+	}
+	return groups
+}
+
+// dirGroupKey returns a directory display key relative to the repository root, with a trailing slash.
+func dirGroupKey(repoPath, dir string) string {
+	rel, err := filepath.Rel(repoPath, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		rel = dir
+	}
+	return filepath.ToSlash(rel) + "/"
+}
+
+// packageGroupKeys groups JVM files by their declared package and every other file by its
+// directory, which is the package unit for Go and the closest equivalent elsewhere.
+func packageGroupKeys(repoPath string, packages map[string]string) func(string) string {
+	return func(filePath string) string {
+		if pkg := packages[filePath]; pkg != "" {
+			return pkg
+		}
+		return dirGroupKey(repoPath, filepath.Dir(filePath))
+	}
+}
+
+// moduleGroupKeys groups files by the nearest enclosing directory that holds a module manifest,
+// falling back to the top-level directory under the repository root.
+func moduleGroupKeys(repoPath string, contentReader vcs.ContentReader) func(string) string {
+	rootCache := make(map[string]string)
+
+	var moduleRoot func(dir string) string
+	moduleRoot = func(dir string) string {
+		if root, ok := rootCache[dir]; ok {
+			return root
+		}
+
+		root := ""
+		if hasModuleManifest(dir, contentReader) {
+			root = dir
+		} else if parent := filepath.Dir(dir); dir != repoPath && parent != dir && isWithinDir(parent, repoPath) {
+			root = moduleRoot(parent)
+		}
+		rootCache[dir] = root
+		return root
+	}
+
+	return func(filePath string) string {
+		dir := filepath.Dir(filePath)
+		if !isWithinDir(dir, repoPath) {
+			return dirGroupKey(repoPath, dir)
+		}
+		if root := moduleRoot(dir); root != "" {
+			return dirGroupKey(repoPath, root)
+		}
+
+		rel, err := filepath.Rel(repoPath, dir)
+		if err != nil || rel == "." {
+			return dirGroupKey(repoPath, repoPath)
+		}
+		topLevel := strings.Split(filepath.ToSlash(rel), "/")[0]
+		return dirGroupKey(repoPath, filepath.Join(repoPath, topLevel))
+	}
+}
+
+func hasModuleManifest(dir string, contentReader vcs.ContentReader) bool {
+	for _, manifest := range moduleManifests {
+		if _, err := contentReader(filepath.Join(dir, manifest)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isWithinDir(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
@@ -0,0 +1,126 @@
+package show
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeGroupByFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("os.MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+}
+
+func runGroupByCommand(t *testing.T, args ...string) string {
+	t.Helper()
+	cmd := NewCommand()
+	cmd.SetArgs(args)
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+	return stdout.String()
+}
+
+func TestGraphInput_GroupByDir_CollapsesFilesWithEdgeWeights(t *testing.T) {
+	repoDir := t.TempDir()
+	writeGroupByFile(t, filepath.Join(repoDir, "web", "app.ts"), "import { a } from '../lib/a';\nimport { b } from '../lib/b';\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "web", "page.ts"), "import { a } from '../lib/a';\nimport { app } from './app';\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "lib", "a.ts"), "import { b } from './b';\nexport const a = 1;\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "lib", "b.ts"), "export const b = 2;\n")
+
+	output := runGroupByCommand(t, "-r", repoDir, "-i", repoDir, "-f", "dot", "--group-by", "dir", "--edge-imports")
+
+	if !strings.Contains(output, `"web/" [label="web/\n2 files"`) {
+		t.Fatalf("expected web/ group node with two files, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"lib/" [label="lib/\n2 files"`) {
+		t.Fatalf("expected lib/ group node with two files, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"web/" -> "lib/" [label="3", tooltip="app.ts:1:1 \"../lib/a\"\napp.ts:2:1 \"../lib/b\"\npage.ts:1:1 \"../lib/a\""];`) {
+		t.Fatalf("expected weighted web/ -> lib/ edge, got:\n%s", output)
+	}
+	if strings.Contains(output, `"app.ts"`) {
+		t.Fatalf("expected file nodes to be collapsed, got:\n%s", output)
+	}
+}
+
+func TestGraphInput_GroupByPackage_UsesDeclaredJavaPackages(t *testing.T) {
+	repoDir := t.TempDir()
+	javaDir := filepath.Join(repoDir, "src", "main", "java", "com", "acme")
+	writeGroupByFile(t, filepath.Join(javaDir, "App.java"), "package com.acme;\n\nimport com.acme.billing.Invoice;\n\npublic class App {}\n")
+	writeGroupByFile(t, filepath.Join(javaDir, "billing", "Invoice.java"), "package com.acme.billing;\n\npublic class Invoice {}\n")
+	writeGroupByFile(t, filepath.Join(javaDir, "billing", "Tax.java"), "package com.acme.billing;\n\npublic class Tax {}\n")
+
+	output := runGroupByCommand(t, "-r", repoDir, "-i", repoDir, "-f", "mermaid", "--group-by", "package")
+
+	if !strings.Contains(output, `["com.acme<br/>1 file"]`) {
+		t.Fatalf("expected com.acme package node, got:\n%s", output)
+	}
+	if !strings.Contains(output, `["com.acme.billing<br/>2 files"]`) {
+		t.Fatalf("expected com.acme.billing package node, got:\n%s", output)
+	}
+	if !strings.Contains(output, "-->|1|") {
+		t.Fatalf("expected weighted package edge, got:\n%s", output)
+	}
+}
+
+func TestGraphInput_GroupByModule_UsesNearestManifestDirectory(t *testing.T) {
+	repoDir := t.TempDir()
+	writeGroupByFile(t, filepath.Join(repoDir, "services", "api", "package.json"), "{}\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "services", "api", "src", "server.js"), "const routes = require('./routes/index.js');\nconst util = require('../../../shared/util.js');\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "services", "api", "src", "routes", "index.js"), "const util = require('../../../../shared/util.js');\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "shared", "util.js"), "module.exports = {};\n")
+
+	output := runGroupByCommand(t, "-r", repoDir, "-i", repoDir, "-f", "dot", "--group-by", "module", "--edge-imports")
+
+	if !strings.Contains(output, `"services/api/" [label="services/api/\n3 files"`) {
+		t.Fatalf("expected services/api/ module node, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"shared/" [label="shared/\n1 file"`) {
+		t.Fatalf("expected shared/ top-level fallback node, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"services/api/" -> "shared/" [label="2", tooltip="index.js:1:14 \"../../../../shared/util.js\"\nserver.js:2:14 \"../../../shared/util.js\""];`) {
+		t.Fatalf("expected weighted module edge, got:\n%s", output)
+	}
+}
+
+func TestGraph_InvalidGroupBy_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--group-by", "team"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatalf("expected error for invalid --group-by")
+	}
+	if !strings.Contains(err.Error(), "unknown group-by: team") {
+		t.Fatalf("expected unknown group-by error, got: %v", err)
+	}
+}
+
+func TestGraph_GroupByDir_MapsFileAndBetweenToGroups(t *testing.T) {
+	repoDir := t.TempDir()
+	writeGroupByFile(t, filepath.Join(repoDir, "web", "app.ts"), "import { a } from '../lib/a';\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "lib", "a.ts"), "import { c } from '../core/c';\nexport const a = 1;\n")
+	writeGroupByFile(t, filepath.Join(repoDir, "core", "c.ts"), "export const c = 3;\n")
+
+	output := runGroupByCommand(t, "-r", repoDir, "-f", "text", "--group-by", "dir", "--between", "web/app.ts,core/c.ts")
+	if !strings.Contains(output, "Paths from web/ to core/:\n  web/ -> lib/ -> core/") {
+		t.Fatalf("expected --between endpoints mapped to their groups, got:\n%s", output)
+	}
+
+	output = runGroupByCommand(t, "-r", repoDir, "-f", "text", "--group-by", "dir", "--file", "web/app.ts")
+	if !strings.HasPrefix(output, "web/ [1 file]\n└── lib/ [1 file]") {
+		t.Fatalf("expected --file root mapped to its group, got:\n%s", output)
+	}
+}
@@ -0,0 +1,188 @@
+package show
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs/git"
+)
+
+// graphLabelData is the data available to --label-template.
+type graphLabelData struct {
+	// Repo is the Go module path or repository directory name.
+	Repo string
+	// Branch is the --branch value or the checked out branch.
+	Branch string
+	// Commit is the short hash, the short hash range, or the current commit with -dirty.
+	Commit string
+	// Author, Date and Subject describe the newest analyzed commit.
+	Author  string
+	Date    time.Time
+	Subject string
+	Files   int
+	Edges   int
+	Cycles  int
+}
+
+// nodeLabelData is the data available to --node-label for each node.
+type nodeLabelData struct {
+	// Name is the default display name: the base name, extended with parent directories
+	// only as far as needed to tell files apart.
+	Name string
+	Base string
+	// Path and Dir are relative to the repository root.
+	Path string
+	Dir  string
+	// Package is the declared JVM package, or the directory for other languages.
+	Package string
+	Ext     string
+	// Files is the number of files behind the node, which is more than one for grouped nodes.
+	Files     int
+	Additions int
+	Deletions int
+	IsNew     bool
+	IsTest    bool
+}
+
+func parseLabelTemplate(flagName, text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New(flagName).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flagName, err)
+	}
+	return tmpl, nil
+}
+
+func executeLabelTemplate(tmpl *template.Template, data any) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", tmpl.Name(), err)
+	}
+	return sb.String(), nil
+}
+
+// renderGraphLabel renders --label-template. Git metadata that cannot be read, e.g. outside a
+// repository, is left empty rather than failing the command.
+func renderGraphLabel(opts *graphOptions, fromCommit, toCommit string, isCommitRange bool, filePaths []string, fileGraph depgraph.FileDependencyGraph) (string, error) {
+	adjacency, err := depgraph.AdjacencyList(fileGraph.Graph)
+	if err != nil {
+		return "", err
+	}
+
+	data := graphLabelData{
+		Repo:   repoLabelName(opts.repoPath),
+		Branch: opts.branch,
+		Files:  len(filePaths),
+		Cycles: len(fileGraph.Meta.Cycles),
+	}
+	for _, deps := range adjacency {
+		data.Edges += len(deps)
+	}
+	if data.Branch == "" {
+		data.Branch, _ = git.GetCurrentBranch(opts.repoPath)
+	}
+	data.Commit, _ = buildCommitLabel(opts, opts.repoPath, fromCommit, toCommit, isCommitRange)
+
+	commit := toCommit
+	if commit == "" {
+		commit = "HEAD"
+	}
+	if info, err := git.GetCommitInfo(opts.repoPath, commit); err == nil {
+		data.Author = info.Author
+		data.Date = info.Date
+		data.Subject = info.Subject
+	}
+
+	return executeLabelTemplate(opts.labelTmpl, data)
+}
+
+// renderNodeNames renders --node-label for every node of fileGraph. packages holds the declared
+// package of each JVM file. Rendered names may repeat, e.g. {{.Dir}} for files of one directory.
+func renderNodeNames(opts *graphOptions, fileGraph depgraph.FileDependencyGraph, packages map[string]string) (map[string]string, error) {
+	if opts.nodeLabelTmpl == nil {
+		return nil, nil
+	}
+
+	adjacency, err := depgraph.AdjacencyList(fileGraph.Graph)
+	if err != nil {
+		return nil, err
+	}
+
+	var filePaths []string
+	for path := range adjacency {
+		if len(fileGraph.Meta.Files[path].Members) == 0 {
+			filePaths = append(filePaths, path)
+		}
+	}
+	defaultNames := formatters.BuildNodeNames(filePaths)
+	packageOf := packageGroupKeys(opts.repoPath, packages)
+
+	paths := make([]string, 0, len(adjacency))
+	for path := range adjacency {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	names := make(map[string]string, len(paths))
+	for _, path := range paths {
+		data := nodeLabelTemplateData(opts.repoPath, path, fileGraph.Meta.Files[path], defaultNames[path], packageOf)
+		name, err := executeLabelTemplate(opts.nodeLabelTmpl, data)
+		if err != nil {
+			return nil, err
+		}
+		names[path] = name
+	}
+	return names, nil
+}
+
+func nodeLabelTemplateData(repoPath, path string, md depgraph.FileMetadata, defaultName string, packageOf func(string) string) nodeLabelData {
+	data := nodeLabelData{
+		Name:   defaultName,
+		Base:   filepath.Base(path),
+		Path:   nodeLabelPath(repoPath, path, md),
+		Ext:    md.Extension,
+		Files:  1,
+		IsTest: md.IsTest,
+	}
+	if md.Stats != nil {
+		data.Additions = md.Stats.Additions
+		data.Deletions = md.Stats.Deletions
+		data.IsNew = md.Stats.IsNew
+	}
+
+	if len(md.Members) > 0 {
+		// Group keys are already repository-relative display names.
+		data.Name = path
+		data.Base = path
+		data.Dir = path
+		data.Package = path
+		data.Files = len(md.Members)
+		return data
+	}
+
+	data.Dir = strings.TrimSuffix(dirGroupKey(repoPath, filepath.Dir(path)), "/")
+	data.Package = strings.TrimSuffix(packageOf(path), "/")
+	if data.Ext == "" {
+		data.Ext = filepath.Ext(path)
+	}
+	return data
+}
+
+func nodeLabelPath(repoPath, path string, md depgraph.FileMetadata) string {
+	if len(md.Members) > 0 {
+		return path
+	}
+	rel, err := filepath.Rel(repoPath, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,47 @@
+package show
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+func TestNodeLabelTemplateData_File(t *testing.T) {
+	repoDir := t.TempDir()
+	path := filepath.Join(repoDir, "src", "app", "main_test.go")
+	md := depgraph.FileMetadata{
+		IsTest: true,
+		Stats:  &vcs.FileStats{Additions: 3, Deletions: 1, IsNew: true},
+	}
+
+	data := nodeLabelTemplateData(repoDir, path, md, "app/main_test.go", func(string) string { return "src/app/" })
+
+	want := nodeLabelData{
+		Name:      "app/main_test.go",
+		Base:      "main_test.go",
+		Path:      "src/app/main_test.go",
+		Dir:       "src/app",
+		Package:   "src/app",
+		Ext:       ".go",
+		Files:     1,
+		Additions: 3,
+		Deletions: 1,
+		IsNew:     true,
+		IsTest:    true,
+	}
+	if data != want {
+		t.Fatalf("nodeLabelTemplateData() = %+v, want %+v", data, want)
+	}
+}
+
+func TestNodeLabelTemplateData_GroupedNode(t *testing.T) {
+	md := depgraph.FileMetadata{Members: []string{"/repo/a.go", "/repo/b.go"}}
+
+	data := nodeLabelTemplateData("/repo", "pkg/", md, "", func(string) string { return "" })
+
+	if data.Path != "pkg/" || data.Package != "pkg/" || data.Files != 2 {
+		t.Fatalf("nodeLabelTemplateData() = %+v, want group key and member count", data)
+	}
+}
@@ -0,0 +1,115 @@
+package show
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/LegacyCodeHQ/clarity/vcs/git"
+)
+
+const (
+	metricChanges = "changes"
+	metricChurn   = "churn"
+	metricHotspot = "hotspot"
+)
+
+// defaultHistorySince bounds the git log read by history metrics, which would otherwise walk
+// every commit of large repositories.
+const defaultHistorySince = "1 year ago"
+
+func supportedMetrics() string {
+	return strings.Join([]string{metricChanges, metricChurn, metricHotspot}, ", ")
+}
+
+// normalizeEncodings parses the comma-separated --encode value.
+func normalizeEncodings(rawEncodings string) ([]formatters.NodeEncoding, error) {
+	if strings.TrimSpace(rawEncodings) == "" {
+		return nil, nil
+	}
+
+	var encodings []formatters.NodeEncoding
+	seen := make(map[formatters.NodeEncoding]bool)
+	for _, part := range strings.Split(rawEncodings, ",") {
+		encoding, ok := formatters.ParseNodeEncoding(part)
+		if !ok {
+			return nil, fmt.Errorf("unknown encoding: %s (valid options: %s)", strings.TrimSpace(part), formatters.SupportedNodeEncodings())
+		}
+		if !seen[encoding] {
+			seen[encoding] = true
+			encodings = append(encodings, encoding)
+		}
+	}
+	return encodings, nil
+}
+
+func normalizeMetric(metric string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(metric))
+	switch normalized {
+	case metricChanges, metricChurn, metricHotspot:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("unknown metric: %s (valid options: %s)", metric, supportedMetrics())
+	}
+}
+
+// nodeMetrics returns the values --encode scales nodes by, with the legend label for them.
+// The changes metric returns nil so formatters use the change stats already on the graph,
+// while history metrics come from git log within --since and are summed over the members of
+// grouped nodes.
+func nodeMetrics(opts *graphOptions, fileGraph depgraph.FileDependencyGraph) (map[string]formatters.NodeMetric, string, error) {
+	if len(opts.encodings) == 0 || opts.metric == metricChanges {
+		return nil, "", nil
+	}
+
+	churn, err := git.GetHistoricalFileChurn(opts.repoPath, opts.since)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get file history: %w", err)
+	}
+
+	metricOf := func(fileChurn vcs.FileChurn) formatters.NodeMetric {
+		metric := formatters.NodeMetric{
+			Churn: fileChurn.Additions + fileChurn.Deletions,
+			Net:   fileChurn.Additions - fileChurn.Deletions,
+		}
+		if opts.metric == metricHotspot {
+			metric.Churn = fileChurn.Commits
+		}
+		return metric
+	}
+
+	metrics := make(map[string]formatters.NodeMetric, len(fileGraph.Meta.Files))
+	for path, md := range fileGraph.Meta.Files {
+		if len(md.Members) == 0 {
+			metrics[path] = metricOf(churn[path])
+			continue
+		}
+		var total formatters.NodeMetric
+		for _, member := range md.Members {
+			memberMetric := metricOf(churn[member])
+			total.Churn += memberMetric.Churn
+			total.Net += memberMetric.Net
+		}
+		metrics[path] = total
+	}
+
+	if opts.metric == metricHotspot {
+		return metrics, "commits " + metricWindow(opts), nil
+	}
+	return metrics, "lines changed " + metricWindow(opts), nil
+}
+
+// metricWindow names the git history that nodeMetrics reads, e.g. "since 1 year ago". It is
+// empty for the changes metric, which reads the change being shown instead.
+func metricWindow(opts *graphOptions) string {
+	switch {
+	case len(opts.encodings) == 0 || opts.metric == metricChanges:
+		return ""
+	case opts.since == "":
+		return "in history"
+	default:
+		return "since " + opts.since
+	}
+}
@@ -0,0 +1,97 @@
+package show
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGraphEncode_UnknownEncoding_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--encode", "size,glow"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown encoding: glow (valid options: size, heatmap)") {
+		t.Fatalf("expected unknown encoding error, got: %v", err)
+	}
+}
+
+func TestGraphEncode_UnknownMetric_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--encode", "size", "--metric", "age"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown metric: age (valid options: changes, churn, hotspot)") {
+		t.Fatalf("expected unknown metric error, got: %v", err)
+	}
+}
+
+func TestGraphEncode_MetricWithoutEncode_ReturnsError(t *testing.T) {
+	for _, args := range [][]string{
+		{"--metric", "hotspot"},
+		{"--since", "6 months ago"},
+	} {
+		cmd := NewCommand()
+		cmd.SetArgs(args)
+
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "--metric and --since require --encode") {
+			t.Fatalf("expected %v to require --encode, got: %v", args, err)
+		}
+	}
+}
+
+func TestGraphEncode_UnsupportedFormat_ReturnsError(t *testing.T) {
+	for _, format := range []string{"plantuml", "d2"} {
+		cmd := NewCommand()
+		cmd.SetArgs([]string{"--encode", "heatmap", "-f", format})
+
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "--encode is not supported with --format "+format) {
+			t.Fatalf("expected --encode to be rejected for %s, got: %v", format, err)
+		}
+	}
+}
+
+func TestGraphEncode_HotspotMetric_SizesNodesByCommitCount(t *testing.T) {
+	repoDir := t.TempDir()
+	gitInitRepo(t, repoDir)
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	writeFile("hot.ts", "import { cold } from './cold';\nexport const hot = cold;\n")
+	writeFile("cold.ts", "export const cold = 1;\n")
+	gitRun(t, repoDir, "add", ".")
+	gitRun(t, repoDir, "commit", "-m", "initial")
+	for _, value := range []string{"2", "3", "4"} {
+		writeFile("hot.ts", "import { cold } from './cold';\nexport const hot = cold + "+value+";\n")
+		gitRun(t, repoDir, "commit", "-am", "change hot "+value)
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "hot.ts", "--encode", "size", "--metric", "hotspot", "-f", "dot"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, `"hot.ts" [label="hot.ts", style=filled, fillcolor=white, penwidth=6.0, peripheries=2];`) {
+		t.Fatalf("expected hot.ts to have the widest border, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"cold.ts" [label="cold.ts", style=filled, fillcolor=white, penwidth=2.2];`) {
+		t.Fatalf("expected cold.ts border scaled by one commit, got:\n%s", output)
+	}
+	if !strings.Contains(output, `label="Border width: commits since 1 year ago (max 4)"`) {
+		t.Fatalf("expected hotspot legend, got:\n%s", output)
+	}
+}
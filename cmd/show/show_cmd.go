@@ -8,11 +8,15 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+	"github.com/LegacyCodeHQ/clarity/cmd/watch"
 	"github.com/LegacyCodeHQ/clarity/depgraph"
 	"github.com/LegacyCodeHQ/clarity/depgraph/registry"
+	"github.com/LegacyCodeHQ/clarity/internal/graphflags"
 	"github.com/LegacyCodeHQ/clarity/internal/mcplogdlog"
+	"github.com/LegacyCodeHQ/clarity/internal/pathglob"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 	"github.com/LegacyCodeHQ/clarity/vcs/git"
 
@@ -23,6 +27,8 @@ type graphOptions struct {
 	outputFormat string
 	repoPath     string
 	commitID     string
+	branch       string
+	base         string
 	generateURL  bool
 	direction    string
 	allowOutside bool
@@ -33,9 +39,22 @@ type graphOptions struct {
 	includes     []string
 	excludes     []string
 	betweenFiles []string
-	targetFile   string
+	targetFiles  []string
 	depthLevel   int
 	scope        string
+	groupBy      string
+	explainEdges bool
+	edgeImports  bool
+	encode       string
+	encodings    []formatters.NodeEncoding
+	metric       string
+	since        string
+	buildOptions depgraph.BuildOptions
+
+	labelTemplate     string
+	labelTmpl         *template.Template
+	nodeLabelTemplate string
+	nodeLabelTmpl     *template.Template
 }
 
 const (
@@ -54,6 +73,8 @@ func NewCommand() *cobra.Command {
 		direction:    formatters.DefaultDirection.StringLower(),
 		depthLevel:   1,
 		scope:        scopeDownstream,
+		metric:       metricChanges,
+		since:        defaultHistorySince,
 	}
 
 	cmd := &cobra.Command{
@@ -78,8 +99,11 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&opts.allowOutside, "allow-outside-repo", false, "Allow input paths outside the repo root")
 	// Add commit flag
 	cmd.Flags().StringVarP(&opts.commitID, "commit", "c", "", "Git commit or range to analyze (e.g., f0459ec, HEAD~3, f0459ec...be3d11a)")
+	// Add branch and base flags for graphing a branch against its merge-base
+	cmd.Flags().StringVar(&opts.branch, "branch", "", "Show every file changed on a branch since its merge-base with --base (default: HEAD when --base is set)")
+	cmd.Flags().StringVar(&opts.base, "base", "", "Branch that --branch is compared against (default: the repository's default branch)")
 	// Add URL flag
-	cmd.Flags().BoolVarP(&opts.generateURL, "url", "u", false, "Generate visualization URL (supported formats: dot, mermaid)")
+	cmd.Flags().BoolVarP(&opts.generateURL, "url", "u", false, "Generate visualization URL (supported formats: dot, mermaid, plantuml)")
 	cmd.Flags().StringVarP(
 		&opts.direction,
 		"direction",
@@ -97,10 +121,23 @@ func NewCommand() *cobra.Command {
 	// Add between flag for finding paths between files
 	cmd.Flags().StringSliceVarP(&opts.betweenFiles, "between", "w", nil, "Find all paths between specified files (comma-separated)")
 	// Add file flag for showing dependencies of a specific file
-	cmd.Flags().StringVarP(&opts.targetFile, "file", "p", "", "Show dependencies for a specific file")
+	cmd.Flags().StringSliceVarP(&opts.targetFiles, "file", "p", nil, "Show dependencies for specific files or glob patterns such as src/payments/** (repeatable)")
 	// Add level flag for limiting dependency depth
 	cmd.Flags().IntVarP(&opts.depthLevel, "level", "l", opts.depthLevel, "Depth level for dependencies (used with --file, 0 = unlimited)")
 	cmd.Flags().StringVar(&opts.scope, "scope", opts.scope, "Dependency scope for --file (downstream only)")
+	// Add group-by flag for collapsing files into aggregate nodes
+	cmd.Flags().StringVar(&opts.groupBy, "group-by", "", fmt.Sprintf("Collapse files into aggregate nodes (%s)", supportedGroupBys()))
+	// Add encode and metric flags for stats-driven node styling
+	cmd.Flags().StringVar(&opts.encode, "encode", "", fmt.Sprintf("Encode node metrics visually in dot and mermaid output (comma-separated: %s)", formatters.SupportedNodeEncodings()))
+	cmd.Flags().StringVar(&opts.metric, "metric", opts.metric, fmt.Sprintf("Metric used by --encode (%s)", supportedMetrics()))
+	cmd.Flags().StringVar(&opts.since, "since", opts.since, fmt.Sprintf("Git history window of the %s and %s metrics, as a git date (empty reads the whole history)", metricChurn, metricHotspot))
+	// Add label template flags for customizing graph and node labels
+	cmd.Flags().StringVar(&opts.labelTemplate, "label-template", "", "Go text/template for the graph label (fields: Repo, Branch, Commit, Author, Date, Subject, Files, Edges, Cycles)")
+	cmd.Flags().StringVar(&opts.nodeLabelTemplate, "node-label", "", "Go text/template for node names (fields: Name, Base, Path, Dir, Package, Ext, Files, Additions, Deletions, IsNew, IsTest)")
+	// Add explain-edges flag for listing the import statements behind each edge
+	cmd.Flags().BoolVar(&opts.explainEdges, "explain-edges", false, "List each dependency with the import statements that create it instead of rendering the graph (Go, JavaScript, TypeScript and Python)")
+	cmd.Flags().BoolVar(&opts.edgeImports, "edge-imports", false, "Annotate edges with the import statements that create them: dot tooltips, mermaid comments and graphml/gexf edge data")
+	graphflags.Register(cmd, &opts.buildOptions)
 
 	return cmd
 }
@@ -113,7 +150,7 @@ func runGraph(cmd *cobra.Command, opts *graphOptions) error {
 		"commit":    opts.commitID,
 		"direction": opts.direction,
 	})
-	if err := validateGraphOptions(opts); err != nil {
+	if err := validateGraphOptions(cmd, opts); err != nil {
 		mcplogdlog.Error("show: invalid options", map[string]any{"error": err.Error()})
 		return err
 	}
@@ -124,6 +161,11 @@ func runGraph(cmd *cobra.Command, opts *graphOptions) error {
 		return fmt.Errorf("failed to create path resolver: %w", err)
 	}
 	opts.repoPath = pathResolver.BaseDir()
+	graphflags.ResolveRoots(&opts.buildOptions, opts.repoPath)
+
+	if err := applyBranchRange(opts); err != nil {
+		return err
+	}
 
 	fromCommit, toCommit, isCommitRange, err := parseCommitRange(opts)
 	if err != nil {
@@ -155,15 +197,23 @@ func runGraph(cmd *cobra.Command, opts *graphOptions) error {
 
 	emitUnsupportedFileWarning(filePaths)
 
+	format, ok := formatters.ParseOutputFormat(opts.outputFormat)
+	if !ok {
+		return fmt.Errorf("unknown format: %s (valid options: %s)", opts.outputFormat, formatters.SupportedFormats())
+	}
+
 	contentReader := selectContentReader(opts, toCommit)
 
-	graph, err := depgraph.BuildDependencyGraph(filePaths, contentReader)
+	// Locating imports parses every file a second time, so only do it when the output shows them.
+	opts.buildOptions.LocateImports = opts.explainEdges || opts.edgeImports
+	built, err := depgraph.BuildDependencyGraphWithOptions(filePaths, contentReader, opts.buildOptions)
+	graph, imports, relations, packages := built.Graph, built.Imports, built.Relations, built.Packages
 	if err != nil {
 		mcplogdlog.Error("show: build dependency graph failed", map[string]any{"error": err.Error()})
 		return fmt.Errorf("failed to build dependency graph: %w", err)
 	}
 
-	graph, filePaths, err = applyTargetFileFilter(opts, pathResolver, graph, filePaths)
+	graph, filePaths, roots, err := applyTargetFileFilter(opts, pathResolver, graph, filePaths)
 	if err != nil {
 		return err
 	}
@@ -173,26 +223,69 @@ func runGraph(cmd *cobra.Command, opts *graphOptions) error {
 		return err
 	}
 
-	format, ok := formatters.ParseOutputFormat(opts.outputFormat)
-	if !ok {
-		return fmt.Errorf("unknown format: %s (valid options: %s)", opts.outputFormat, formatters.SupportedFormats())
-	}
 	fileStats := collectFileStats(cmd, opts, format, fromCommit, toCommit, isCommitRange)
 	label := buildGraphLabel(opts, format, fromCommit, toCommit, isCommitRange, filePaths)
 	fileGraph, err := depgraph.NewFileDependencyGraph(graph, fileStats, contentReader)
 	if err != nil {
 		return fmt.Errorf("failed to build file graph metadata: %w", err)
 	}
+	depgraph.AttachImportLocations(fileGraph, imports)
+	depgraph.AttachEdgeRelations(fileGraph, relations)
+
+	groupOf := groupKeyFunc(opts, packages, contentReader)
+	fileGraph, err = applyGroupBy(opts, fileGraph, groupOf)
+	if err != nil {
+		return err
+	}
+	roots = groupNodes(roots, groupOf)
+
+	nodeNames, err := renderNodeNames(opts, fileGraph, packages)
+	if err != nil {
+		return err
+	}
+	if opts.labelTmpl != nil && includesGraphMetadata(format) {
+		label, err = renderGraphLabel(opts, fromCommit, toCommit, isCommitRange, filePaths, fileGraph)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.explainEdges {
+		explanation, err := formatters.ExplainEdges(fileGraph, formatters.RenderOptions{NodeNames: nodeNames})
+		if err != nil {
+			return fmt.Errorf("failed to explain edges: %w", err)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), explanation)
+		return nil
+	}
+
+	if annotatesCycleBreaks(format) {
+		if err := depgraph.AttachCycleBreaks(fileGraph); err != nil {
+			return fmt.Errorf("failed to suggest cycle breaks: %w", err)
+		}
+	}
 
-	formatter, err := formatters.NewFormatter(opts.outputFormat)
+	formatter, err := newFormatter(format)
 	if err != nil {
 		return err
 	}
 
 	direction, _ := formatters.ParseDirection(opts.direction)
+	endpoints := groupNodes(betweenEndpoints(opts, pathResolver, graph), groupOf)
+	metrics, metricLabel, err := nodeMetrics(opts, fileGraph)
+	if err != nil {
+		return err
+	}
 	renderOpts := formatters.RenderOptions{
-		Label:     label,
-		Direction: direction,
+		Label:        label,
+		Direction:    direction,
+		Roots:        roots,
+		Endpoints:    endpoints,
+		Encodings:    opts.encodings,
+		Metrics:      metrics,
+		MetricLabel:  metricLabel,
+		MetricWindow: metricWindow(opts),
+		NodeNames:    nodeNames,
 	}
 
 	output, err := formatter.Format(fileGraph, renderOpts)
@@ -203,7 +296,20 @@ func runGraph(cmd *cobra.Command, opts *graphOptions) error {
 	return emitOutput(cmd, opts, format, formatter, output)
 }
 
-func validateGraphOptions(opts *graphOptions) error {
+// newFormatter creates the formatter for format, embedding the watch viewer into HTML reports.
+func newFormatter(format formatters.OutputFormat) (formatters.Formatter, error) {
+	if format != formatters.OutputFormatHTML {
+		return formatters.NewFormatter(format.String())
+	}
+
+	viewer, err := watch.ViewerFS()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load viewer assets: %w", err)
+	}
+	return formatters.NewHTMLFormatter(viewer), nil
+}
+
+func validateGraphOptions(cmd *cobra.Command, opts *graphOptions) error {
 	direction, ok := formatters.ParseDirection(opts.direction)
 	if !ok {
 		return fmt.Errorf("unknown direction: %s (valid options: %s)", opts.direction, formatters.SupportedDirections())
@@ -234,11 +340,51 @@ func validateGraphOptions(opts *graphOptions) error {
 		return fmt.Errorf("unknown scope: %s (valid options: %s)", opts.scope, scopeDownstream)
 	}
 
+	groupBy, err := normalizeGroupBy(opts.groupBy)
+	if err != nil {
+		return err
+	}
+	opts.groupBy = groupBy
+
+	encodings, err := normalizeEncodings(opts.encode)
+	if err != nil {
+		return err
+	}
+	opts.encodings = encodings
+
+	metric, err := normalizeMetric(opts.metric)
+	if err != nil {
+		return err
+	}
+	opts.metric = metric
+
+	if len(opts.encodings) == 0 && (cmd.Flags().Changed("metric") || cmd.Flags().Changed("since")) {
+		return fmt.Errorf("--metric and --since require --encode")
+	}
+	if format, ok := formatters.ParseOutputFormat(opts.outputFormat); ok && len(opts.encodings) > 0 && !encodesNodes(format) {
+		return fmt.Errorf("--encode is not supported with --format %s (valid formats: dot, mermaid, html, svg, png)", format)
+	}
+
+	if opts.labelTmpl, err = parseLabelTemplate("--label-template", opts.labelTemplate); err != nil {
+		return err
+	}
+	if opts.nodeLabelTmpl, err = parseLabelTemplate("--node-label", opts.nodeLabelTemplate); err != nil {
+		return err
+	}
+
+	if (opts.branch != "" || opts.base != "") && opts.commitID != "" {
+		return fmt.Errorf("--branch and --base cannot be used with --commit flag")
+	}
+
+	if opts.explainEdges && opts.generateURL {
+		return fmt.Errorf("--explain-edges cannot be used with --url flag")
+	}
+
 	if len(opts.betweenFiles) > 0 && len(opts.includes) > 0 {
 		return fmt.Errorf("--between cannot be used with --input flag")
 	}
 
-	if opts.targetFile != "" {
+	if len(opts.targetFiles) > 0 {
 		if len(opts.betweenFiles) > 0 {
 			return fmt.Errorf("--file cannot be used with --between flag")
 		}
@@ -248,6 +394,14 @@ func validateGraphOptions(opts *graphOptions) error {
 		if opts.depthLevel < 0 {
 			return fmt.Errorf("--level must be at least 0")
 		}
+		for _, pattern := range opts.targetFiles {
+			if !isGlobPattern(pattern) {
+				continue
+			}
+			if err := pathglob.Validate(filepath.ToSlash(pattern)); err != nil {
+				return fmt.Errorf("invalid --file pattern: %w", err)
+			}
+		}
 	}
 
 	return nil
@@ -294,6 +448,35 @@ func ensureRepoPath(opts *graphOptions) {
 	}
 }
 
+// applyBranchRange turns --branch and --base into the range a pull request shows: every commit
+// on the branch since its merge-base with the base branch.
+func applyBranchRange(opts *graphOptions) error {
+	if opts.branch == "" && opts.base == "" {
+		return nil
+	}
+
+	branch := opts.branch
+	if branch == "" {
+		branch = "HEAD"
+	}
+	base := opts.base
+	if base == "" {
+		defaultBranch, err := git.GetDefaultBranch(opts.repoPath)
+		if err != nil {
+			return err
+		}
+		base = defaultBranch
+	}
+
+	mergeBase, err := git.GetMergeBase(opts.repoPath, base, branch)
+	if err != nil {
+		return fmt.Errorf("failed to find merge-base of %s and %s: %w", base, branch, err)
+	}
+
+	opts.commitID = fmt.Sprintf("%s...%s", mergeBase, branch)
+	return nil
+}
+
 func parseCommitRange(opts *graphOptions) (string, string, bool, error) {
 	var fromCommit, toCommit string
 	var isCommitRange bool
@@ -360,7 +543,7 @@ func determineFilePaths(cmd *cobra.Command, opts *graphOptions, pathResolver Pat
 		return filePaths, false, nil
 	}
 
-	if opts.targetFile != "" {
+	if len(opts.targetFiles) > 0 {
 		filePaths, err := expandPaths([]string{opts.repoPath}, false)
 		if err != nil {
 			return nil, false, fmt.Errorf("failed to expand working directory: %w", err)
@@ -473,30 +656,28 @@ func collectCommitFilePaths(opts *graphOptions, fromCommit, toCommit string, isC
 }
 
 func selectContentReader(opts *graphOptions, toCommit string) vcs.ContentReader {
-	if toCommit != "" && opts.targetFile == "" {
+	if toCommit != "" && len(opts.targetFiles) == 0 {
 		return git.GitCommitContentReader(opts.repoPath, toCommit)
 	}
 	return vcs.FilesystemContentReader()
 }
 
-func applyTargetFileFilter(opts *graphOptions, pathResolver PathResolver, graph depgraph.DependencyGraph, filePaths []string) (depgraph.DependencyGraph, []string, error) {
-	if opts.targetFile == "" {
-		return graph, filePaths, nil
+// applyTargetFileFilter narrows the graph to the union neighborhood of the --file targets and
+// returns the targets, which formatters distinguish from the files discovered around them.
+func applyTargetFileFilter(opts *graphOptions, pathResolver PathResolver, graph depgraph.DependencyGraph, filePaths []string) (depgraph.DependencyGraph, []string, []string, error) {
+	if len(opts.targetFiles) == 0 {
+		return graph, filePaths, nil, nil
 	}
 
-	absTargetFile, err := pathResolver.Resolve(RawPath(opts.targetFile))
+	targets, err := resolveTargetFiles(opts.targetFiles, pathResolver, graph)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to resolve file path: %w", err)
+		return nil, nil, nil, err
 	}
 
-	if !depgraph.ContainsNode(graph, absTargetFile.String()) {
-		return nil, nil, fmt.Errorf("file not found in graph: %s", opts.targetFile)
-	}
-
-	graph = filterGraphByLevel(graph, absTargetFile.String(), opts.depthLevel, opts.scope)
+	graph = filterGraphByLevel(graph, targets, opts.depthLevel, opts.scope)
 	filePaths = graphFiles(graph)
 
-	return graph, filePaths, nil
+	return graph, filePaths, targets, nil
 }
 
 func applyBetweenFilter(opts *graphOptions, pathResolver PathResolver, graph depgraph.DependencyGraph, filePaths []string) (depgraph.DependencyGraph, []string, error) {
@@ -518,6 +699,15 @@ func applyBetweenFilter(opts *graphOptions, pathResolver PathResolver, graph dep
 	return graph, filePaths, nil
 }
 
+// betweenEndpoints returns the resolved --between paths, which text output lays out as a path list.
+func betweenEndpoints(opts *graphOptions, pathResolver PathResolver, graph depgraph.DependencyGraph) []string {
+	if len(opts.betweenFiles) == 0 {
+		return nil
+	}
+	endpoints, _ := resolveAndValidatePaths(opts.betweenFiles, pathResolver, graph)
+	return endpoints
+}
+
 func graphFiles(graph depgraph.DependencyGraph) []string {
 	adjacency, err := depgraph.AdjacencyList(graph)
 	if err != nil {
@@ -531,7 +721,7 @@ func graphFiles(graph depgraph.DependencyGraph) []string {
 }
 
 func collectFileStats(cmd *cobra.Command, opts *graphOptions, format formatters.OutputFormat, fromCommit, toCommit string, isCommitRange bool) map[string]vcs.FileStats {
-	if format != formatters.OutputFormatDOT && format != formatters.OutputFormatMermaid {
+	if !includesGraphMetadata(format) {
 		return nil
 	}
 
@@ -558,8 +748,45 @@ func collectFileStats(cmd *cobra.Command, opts *graphOptions, format formatters.
 	return fileStats
 }
 
+// encodesNodes reports whether the format applies --encode to its nodes. HTML, SVG and PNG are
+// rendered from DOT.
+func encodesNodes(format formatters.OutputFormat) bool {
+	switch format {
+	case formatters.OutputFormatDOT, formatters.OutputFormatMermaid, formatters.OutputFormatHTML,
+		formatters.OutputFormatSVG, formatters.OutputFormatPNG:
+		return true
+	default:
+		return false
+	}
+}
+
+// annotatesCycleBreaks reports whether the format marks suggested cycle breaks. The matrix views
+// show cycles but not how to break them.
+func annotatesCycleBreaks(format formatters.OutputFormat) bool {
+	switch format {
+	case formatters.OutputFormatDSM, formatters.OutputFormatDSMCSV, formatters.OutputFormatDSMHTML:
+		return false
+	default:
+		return true
+	}
+}
+
+// includesGraphMetadata reports whether the format carries the graph label and file stats.
+func includesGraphMetadata(format formatters.OutputFormat) bool {
+	switch format {
+	case formatters.OutputFormatDOT, formatters.OutputFormatMermaid, formatters.OutputFormatPlantUML, formatters.OutputFormatD2,
+		formatters.OutputFormatGraphML, formatters.OutputFormatGEXF, formatters.OutputFormatHTML,
+		formatters.OutputFormatSVG, formatters.OutputFormatPNG,
+		formatters.OutputFormatDSM, formatters.OutputFormatDSMCSV, formatters.OutputFormatDSMHTML,
+		formatters.OutputFormatText:
+		return true
+	default:
+		return false
+	}
+}
+
 func buildGraphLabel(opts *graphOptions, format formatters.OutputFormat, fromCommit, toCommit string, isCommitRange bool, filePaths []string) string {
-	if format != formatters.OutputFormatDOT && format != formatters.OutputFormatMermaid {
+	if !includesGraphMetadata(format) {
 		return ""
 	}
 
@@ -569,30 +796,11 @@ func buildGraphLabel(opts *graphOptions, format formatters.OutputFormat, fromCom
 	}
 
 	label := fmt.Sprintf("%s • ", repoLabelName(labelRepoPath))
-	var err error
-
-	var commitLabel string
-	if opts.commitID != "" {
-		if isCommitRange {
-			commitLabel, err = git.GetCommitRangeLabel(labelRepoPath, fromCommit, toCommit)
-		} else {
-			commitLabel, err = git.GetShortCommitHash(labelRepoPath, toCommit)
-		}
-	} else {
-		commitLabel, err = git.GetCurrentCommitHash(labelRepoPath)
-	}
-
+	commitLabel, err := buildCommitLabel(opts, labelRepoPath, fromCommit, toCommit, isCommitRange)
 	if err != nil {
 		return ""
 	}
-
 	label += commitLabel
-	if opts.commitID == "" {
-		isDirty, err := git.HasUncommittedChanges(labelRepoPath)
-		if err == nil && isDirty {
-			label += "-dirty"
-		}
-	}
 
 	fileCount := len(filePaths)
 	if fileCount == 1 {
@@ -604,6 +812,27 @@ func buildGraphLabel(opts *graphOptions, format formatters.OutputFormat, fromCom
 	return label
 }
 
+// buildCommitLabel describes the analyzed revision: a short hash, a range of short hashes, or the
+// current commit with a -dirty suffix when there are uncommitted changes.
+func buildCommitLabel(opts *graphOptions, repoPath, fromCommit, toCommit string, isCommitRange bool) (string, error) {
+	if opts.commitID != "" {
+		if isCommitRange {
+			return git.GetCommitRangeLabel(repoPath, fromCommit, toCommit)
+		}
+		return git.GetShortCommitHash(repoPath, toCommit)
+	}
+
+	commitLabel, err := git.GetCurrentCommitHash(repoPath)
+	if err != nil {
+		return "", err
+	}
+	isDirty, err := git.HasUncommittedChanges(repoPath)
+	if err == nil && isDirty {
+		commitLabel += "-dirty"
+	}
+	return commitLabel, nil
+}
+
 func repoLabelName(repoPath string) string {
 	if moduleName := goModuleLabelName(repoPath); moduleName != "" {
 		return moduleName
@@ -663,6 +892,9 @@ func emitOutput(cmd *cobra.Command, opts *graphOptions, format formatters.Output
 			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: URL generation is not supported for %s format\n\n", format)
 			fmt.Fprintln(cmd.OutOrStdout(), output)
 		}
+	} else if format == formatters.OutputFormatPNG {
+		// Binary output is written as-is so it can be redirected straight into a file.
+		fmt.Fprint(cmd.OutOrStdout(), output)
 	} else {
 		fmt.Fprintln(cmd.OutOrStdout(), output)
 	}
@@ -862,9 +1094,9 @@ func resolveAndValidatePaths(paths []string, pathResolver PathResolver, graph de
 }
 
 // filterGraphByLevel filters the dependency graph to include only nodes within
-// the specified number of levels from the target file, according to scope.
+// the specified number of levels from any of the target files, according to scope.
 // A level of 0 means unlimited traversal depth.
-func filterGraphByLevel(graph depgraph.DependencyGraph, targetFile string, level int, scope string) depgraph.DependencyGraph {
+func filterGraphByLevel(graph depgraph.DependencyGraph, targetFiles []string, level int, scope string) depgraph.DependencyGraph {
 	adjacency, err := depgraph.AdjacencyList(graph)
 	if err != nil {
 		return depgraph.NewDependencyGraph()
@@ -872,9 +1104,11 @@ func filterGraphByLevel(graph depgraph.DependencyGraph, targetFile string, level
 
 	// BFS to find all nodes within the specified level (or all reachable nodes when level=0)
 	visited := make(map[string]bool)
-	visited[targetFile] = true
+	for _, targetFile := range targetFiles {
+		visited[targetFile] = true
+	}
 
-	currentLevel := []string{targetFile}
+	currentLevel := append([]string(nil), targetFiles...)
 	for l := 0; (level == 0 || l < level) && len(currentLevel) > 0; l++ {
 		nextLevel := []string{}
 		for _, file := range currentLevel {
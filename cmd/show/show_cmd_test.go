@@ -2,11 +2,14 @@ package show
 
 import (
 	"bytes"
+	"fmt"
+	"image/png"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
 )
@@ -204,6 +207,64 @@ func TestGraphInput_WithSupportedFiles_RendersNode(t *testing.T) {
 	}
 }
 
+func TestGraphInput_WithAdditionalFormats_RendersNode(t *testing.T) {
+	repoDir := t.TempDir()
+	supportedFile := filepath.Join(repoDir, "main.go")
+	if err := os.WriteFile(supportedFile, []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+
+	tests := map[string]string{
+		"plantuml": `rectangle "main.go" as n0 #white`,
+		"d2":       `n0: "main.go" {`,
+		"graphml":  `<data key="name">main.go</data>`,
+		"gexf":     `<node id="n0" label="main.go">`,
+		"svg":      `<title>main.go</title>`,
+		"dsm":      `    0  1  main.go -`,
+		"dsm-csv":  "layer,file,main.go\n0,main.go,",
+		"dsm-html": `<th class="file" title="`,
+		"text":     "Layer 0\n  main.go",
+	}
+	for format, want := range tests {
+		cmd := NewCommand()
+		cmd.SetArgs([]string{"-i", supportedFile, "-f", format, "--allow-outside-repo"})
+
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("cmd.Execute() with -f %s error = %v", format, err)
+		}
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %s output to include %q, got:\n%s", format, want, stdout.String())
+		}
+	}
+}
+
+func TestGraphInput_WithPNGFormat_WritesRawImage(t *testing.T) {
+	repoDir := t.TempDir()
+	supportedFile := filepath.Join(repoDir, "main.go")
+	if err := os.WriteFile(supportedFile, []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-i", supportedFile, "-f", "png", "--allow-outside-repo"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+	if _, err := png.Decode(bytes.NewReader(stdout.Bytes())); err != nil {
+		t.Fatalf("expected stdout to be a PNG image, got decode error: %v", err)
+	}
+	if !bytes.HasSuffix(stdout.Bytes(), []byte("IEND\xaeB`\x82")) {
+		t.Fatalf("expected PNG output to end with the IEND chunk and no trailing newline")
+	}
+}
+
 func TestGraphInput_WithJSONFormat_ReturnsError(t *testing.T) {
 	repoDir := t.TempDir()
 	supportedFile := filepath.Join(repoDir, "main.go")
@@ -218,7 +279,7 @@ func TestGraphInput_WithJSONFormat_ReturnsError(t *testing.T) {
 	if err == nil {
 		t.Fatalf("cmd.Execute() expected error for json format, got nil")
 	}
-	if !strings.Contains(err.Error(), "unknown format: json (valid options: dot, mermaid)") {
+	if !strings.Contains(err.Error(), "unknown format: json (valid options: dot, mermaid, plantuml, d2, graphml, gexf, html, svg, png, dsm, dsm-csv, dsm-html, text)") {
 		t.Fatalf("expected unknown format error including input value, got: %v", err)
 	}
 }
@@ -715,6 +776,19 @@ func TestGraphFile_InvalidScope_ReturnsError(t *testing.T) {
 	}
 }
 
+func TestGraphFile_MalformedGlob_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-p", "src/[payments/**"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatalf("expected error for malformed --file glob")
+	}
+	if !strings.Contains(err.Error(), `invalid --file pattern: bad glob "src/[payments/**"`) {
+		t.Fatalf("expected bad glob error, got: %v", err)
+	}
+}
+
 func TestRepoLabelName_UsesGoModuleNameWhenPresent(t *testing.T) {
 	repoDir := filepath.Join(t.TempDir(), "clarity-cli")
 	if err := os.MkdirAll(repoDir, 0o755); err != nil {
@@ -791,3 +865,425 @@ func gitRun(t *testing.T, repoDir string, args ...string) {
 		t.Fatalf("git %v failed: %v\nstderr: %s", args, err, strings.TrimSpace(stderr.String()))
 	}
 }
+
+func TestGraphFile_TextFormat_PrintsDependencyTree(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"a.ts": "import { b } from './b';\nexport const a = b;\n",
+		"b.ts": "import { c } from './c';\nexport const b = c;\n",
+		"c.ts": "export const c = 1;\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "a.ts", "-l", "0", "-f", "text"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "a.ts\n└── b.ts\n    └── c.ts\n") {
+		t.Fatalf("expected dependency tree rooted at a.ts, got:\n%s", stdout.String())
+	}
+}
+
+func TestGraphBetween_TextFormat_ListsPaths(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"a.ts": "import { b } from './b';\nexport const a = b;\n",
+		"b.ts": "import { c } from './c';\nexport const b = c;\n",
+		"c.ts": "export const c = 1;\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-w", "a.ts,c.ts", "-f", "text"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "Paths from a.ts to c.ts:\n  a.ts -> b.ts -> c.ts\n") {
+		t.Fatalf("expected path list between a.ts and c.ts, got:\n%s", stdout.String())
+	}
+}
+
+func TestGraphFile_ExplainEdges_ListsImportStatements(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"a.ts": "import React from 'react';\nimport { b } from './b';\nexport const a = b;\n",
+		"b.ts": "import { c } from './c';\nexport const b = c;\n",
+		"c.ts": "export const c = 1;\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "a.ts", "-l", "0", "--explain-edges"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	want := "a.ts -> b.ts\n  a.ts:2:1 \"./b\"\nb.ts -> c.ts\n  b.ts:1:1 \"./c\"\n"
+	if stdout.String() != want {
+		t.Fatalf("unexpected edge explanation:\n%s\nwant:\n%s", stdout.String(), want)
+	}
+}
+
+func TestGraphFile_EdgeImports_AnnotatesEdgesOnlyWhenRequested(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"a.ts": "import { b } from './b';\nexport const a = b;\n",
+		"b.ts": "export const b = 1;\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{args: nil, want: `"a.ts" -> "b.ts";`},
+		{args: []string{"--edge-imports"}, want: `"a.ts" -> "b.ts" [tooltip="a.ts:1:1 \"./b\""];`},
+	} {
+		cmd := NewCommand()
+		cmd.SetArgs(append([]string{"-r", repoDir, "-p", "a.ts", "-f", "dot"}, tc.args...))
+
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("cmd.Execute() error = %v", err)
+		}
+
+		if output := stdout.String(); !strings.Contains(output, tc.want) {
+			t.Fatalf("expected %v output to contain %s, got:\n%s", tc.args, tc.want, output)
+		}
+	}
+}
+
+func TestGraphExplainEdges_WithURL_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--explain-edges", "-u"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--explain-edges cannot be used with --url flag") {
+		t.Fatalf("expected --explain-edges/--url error, got: %v", err)
+	}
+}
+
+func TestGraphFile_RepeatedAndGlobTargets_ShowUnionNeighborhood(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"payments/api.ts":    "import { client } from './client';\nexport const api = client;\n",
+		"payments/client.ts": "import { http } from '../shared/http';\nexport const client = http;\n",
+		"orders/cart.ts":     "import { money } from '../shared/money';\nexport const cart = money;\n",
+		"shared/http.ts":     "export const http = 1;\n",
+		"shared/money.ts":    "export const money = 1;\n",
+		"admin/panel.ts":     "import { api } from '../payments/api';\nexport const panel = api;\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(repoDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("os.MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "payments/**", "-p", "orders/cart.ts", "-f", "dot"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	output := stdout.String()
+	for _, seed := range []string{"api.ts", "client.ts", "cart.ts"} {
+		if !strings.Contains(output, fmt.Sprintf("%q [label=%q, style=filled, fillcolor=white, peripheries=2];", seed, seed)) {
+			t.Fatalf("expected seed %s to be outlined, got:\n%s", seed, output)
+		}
+	}
+	for _, discovered := range []string{"http.ts", "money.ts"} {
+		if !strings.Contains(output, fmt.Sprintf("%q [label=%q, style=filled, fillcolor=white];", discovered, discovered)) {
+			t.Fatalf("expected discovered file %s without outline, got:\n%s", discovered, output)
+		}
+	}
+	if strings.Contains(output, `"panel.ts"`) {
+		t.Fatalf("expected upstream admin/panel.ts to be excluded, got:\n%s", output)
+	}
+}
+
+func TestGraphBranch_ShowsFilesChangedSinceMergeBase(t *testing.T) {
+	repoDir := t.TempDir()
+	gitInitRepo(t, repoDir)
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	writeFile("a.ts", "export const a = 1;\n")
+	writeFile("b.ts", "export const b = 1;\n")
+	gitRun(t, repoDir, "add", ".")
+	gitRun(t, repoDir, "commit", "-m", "base")
+	gitRun(t, repoDir, "branch", "-M", "main")
+
+	gitRun(t, repoDir, "checkout", "-b", "feature")
+	writeFile("c.ts", "import { a } from './a';\nexport const c = a;\n")
+	gitRun(t, repoDir, "add", ".")
+	gitRun(t, repoDir, "commit", "-m", "add c")
+	writeFile("a.ts", "export const a = 2;\n")
+	gitRun(t, repoDir, "commit", "-am", "change a")
+
+	gitRun(t, repoDir, "checkout", "main")
+	writeFile("b.ts", "export const b = 2;\n")
+	gitRun(t, repoDir, "commit", "-am", "change b on main")
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "--branch", "feature", "--base", "main", "-f", "dot"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, `"c.ts" -> "a.ts"`) {
+		t.Fatalf("expected branch edge c.ts -> a.ts, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"🪴 c.ts\n+2"`) || !strings.Contains(output, `"a.ts\n+1 -1"`) {
+		t.Fatalf("expected aggregated branch stats, got:\n%s", output)
+	}
+	if strings.Contains(output, `"b.ts"`) {
+		t.Fatalf("expected main-only change b.ts to be excluded, got:\n%s", output)
+	}
+}
+
+func TestGraphBranch_WithCommit_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--branch", "feature", "-c", "HEAD"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--branch and --base cannot be used with --commit flag") {
+		t.Fatalf("expected --branch/--commit error, got: %v", err)
+	}
+}
+
+func TestGraphLabelTemplate_RendersCommitMetadataAndCounts(t *testing.T) {
+	repoDir := filepath.Join(t.TempDir(), "shop")
+	if err := os.MkdirAll(repoDir, 0o755); err != nil {
+		t.Fatalf("os.MkdirAll() error = %v", err)
+	}
+	gitInitRepo(t, repoDir)
+	files := map[string]string{
+		"a.ts": "import { b } from './b';\nexport const a = b;\n",
+		"b.ts": "import { a } from './a';\nexport const b = a;\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+	gitRun(t, repoDir, "add", ".")
+	gitRun(t, repoDir, "commit", "-m", "add cycle")
+	gitRun(t, repoDir, "branch", "-M", "main")
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{
+		"-r", repoDir, "-c", "HEAD", "-f", "dot",
+		"--label-template", "{{.Repo}}@{{.Branch}}: {{.Subject}} by {{.Author}} in {{.Date.Year}} ({{.Files}} files, {{.Edges}} edges, {{.Cycles}} cycles)",
+	})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	want := fmt.Sprintf(`label="shop@main: add cycle by test in %d (2 files, 2 edges, 1 cycles)"`, time.Now().Year())
+	if !strings.Contains(stdout.String(), want) {
+		t.Fatalf("expected templated label %s, got:\n%s", want, stdout.String())
+	}
+}
+
+func TestGraphNodeLabel_ReplacesNodeNames(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"web/a.ts": "import { b } from '../lib/b';\nexport const a = b;\n",
+		"lib/b.ts": "export const b = 1;\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(repoDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("os.MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "web/a.ts", "-f", "dot", "--node-label", "{{.Path}}"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, `"a.ts" [label="web/a.ts"`) || !strings.Contains(output, `"b.ts" [label="lib/b.ts"`) {
+		t.Fatalf("expected nodes labeled by repository path, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"a.ts" -> "b.ts"`) {
+		t.Fatalf("expected node IDs to keep the default names, got:\n%s", output)
+	}
+}
+
+func TestGraphNodeLabel_RepeatedNamesKeepDistinctNodes(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"web/a.ts": "import { b } from './b';\nexport const a = b;\n",
+		"web/b.ts": "export const b = 1;\n",
+	}
+	if err := os.MkdirAll(filepath.Join(repoDir, "web"), 0o755); err != nil {
+		t.Fatalf("os.MkdirAll() error = %v", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(repoDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "web/a.ts", "-f", "dot", "--node-label", "{{.Dir}}"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, `"a.ts" [label="web"`) || !strings.Contains(output, `"b.ts" [label="web"`) {
+		t.Fatalf("expected both nodes labeled by their directory, got:\n%s", output)
+	}
+	if !strings.Contains(output, `"a.ts" -> "b.ts"`) {
+		t.Fatalf("expected the edge between the distinct nodes, got:\n%s", output)
+	}
+}
+
+func TestGraphLabelTemplate_InvalidTemplate_ReturnsError(t *testing.T) {
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"--label-template", "{{.Repo"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --label-template") {
+		t.Fatalf("expected invalid --label-template error, got: %v", err)
+	}
+}
+
+func TestGraphProtoRoot_ResolvesAgainstRepo(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"services/billing/service.proto":        "syntax = \"proto3\";\nimport \"common/money.proto\";\n",
+		"third_party/protos/common/money.proto": "syntax = \"proto3\";\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(repoDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("os.MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-p", "services/billing/service.proto", "-f", "dot", "--proto-root", "third_party/protos"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	if output := stdout.String(); !strings.Contains(output, `"service.proto" -> "money.proto"`) {
+		t.Fatalf("expected the import to resolve against the proto root inside the repo, got:\n%s", output)
+	}
+}
+
+func TestGraphProtoGeneratedEdges_DrawsGeneratedFromEdges(t *testing.T) {
+	repoDir := t.TempDir()
+	files := map[string]string{
+		"proto/payments.proto": "syntax = \"proto3\";\n",
+		"gen/payments.pb.go":   "package gen\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(repoDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("os.MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		format string
+		want   string
+	}{
+		{format: "dot", want: `"payments.pb.go" -> "payments.proto" [style=dashed, label="generated from"]`},
+		{format: "mermaid", want: `-.->|generated from|`},
+	} {
+		cmd := NewCommand()
+		cmd.SetArgs([]string{"-r", repoDir, "-p", "gen/payments.pb.go", "-f", tc.format, "--proto-generated-edges"})
+
+		var stdout bytes.Buffer
+		cmd.SetOut(&stdout)
+
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("cmd.Execute() error = %v", err)
+		}
+
+		if output := stdout.String(); !strings.Contains(output, tc.want) {
+			t.Fatalf("expected %s output to contain %q, got:\n%s", tc.format, tc.want, output)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package show
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/internal/pathglob"
+)
+
+// resolveTargetFiles resolves --file values to graph nodes. Plain paths must name a file in the
+// graph, while glob patterns such as src/payments/** must match at least one.
+func resolveTargetFiles(patterns []string, pathResolver PathResolver, graph depgraph.DependencyGraph) ([]string, error) {
+	adjacency, err := depgraph.AdjacencyList(graph)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var targets []string
+	for _, pattern := range patterns {
+		absPattern, err := pathResolver.Resolve(RawPath(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve file path: %w", err)
+		}
+
+		var matches []string
+		if isGlobPattern(pattern) {
+			for node := range adjacency {
+				if pathglob.Match(filepath.ToSlash(absPattern.String()), filepath.ToSlash(node)) {
+					matches = append(matches, node)
+				}
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no files in graph match pattern: %s", pattern)
+			}
+		} else {
+			if !depgraph.ContainsNode(graph, absPattern.String()) {
+				return nil, fmt.Errorf("file not found in graph: %s", pattern)
+			}
+			matches = []string{absPattern.String()}
+		}
+
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				targets = append(targets, match)
+			}
+		}
+	}
+
+	sort.Strings(targets)
+	return targets, nil
+}
+
+func isGlobPattern(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
@@ -0,0 +1,52 @@
+package show
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
+
+func TestResolveTargetFiles_UnionsPathsAndGlobMatches(t *testing.T) {
+	repoDir := t.TempDir()
+	resolver, err := NewPathResolver(repoDir, false)
+	if err != nil {
+		t.Fatalf("NewPathResolver() error = %v", err)
+	}
+	base := resolver.BaseDir()
+	api := filepath.Join(base, "src", "payments", "api.ts")
+	client := filepath.Join(base, "src", "payments", "stripe", "client.ts")
+	orders := filepath.Join(base, "src", "orders", "api.ts")
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		api:    {client},
+		client: {},
+		orders: {api},
+	})
+
+	targets, err := resolveTargetFiles([]string{"src/payments/**", "src/orders/api.ts", "src/payments/api.ts"}, resolver, graph)
+	if err != nil {
+		t.Fatalf("resolveTargetFiles() error = %v", err)
+	}
+
+	want := []string{orders, api, client}
+	if !reflect.DeepEqual(targets, want) {
+		t.Fatalf("expected %v, got %v", want, targets)
+	}
+}
+
+func TestResolveTargetFiles_UnmatchedGlob_ReturnsError(t *testing.T) {
+	resolver, err := NewPathResolver(t.TempDir(), false)
+	if err != nil {
+		t.Fatalf("NewPathResolver() error = %v", err)
+	}
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		filepath.Join(resolver.BaseDir(), "a.ts"): {},
+	})
+
+	_, err = resolveTargetFiles([]string{"lib/**"}, resolver, graph)
+	if err == nil || !strings.Contains(err.Error(), "no files in graph match pattern: lib/**") {
+		t.Fatalf("expected unmatched pattern error, got: %v", err)
+	}
+}
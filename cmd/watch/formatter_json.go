@@ -30,9 +30,18 @@ type jsonNodeStats struct {
 }
 
 type jsonGraphEdge struct {
-	From    string `json:"from"`
-	To      string `json:"to"`
-	InCycle bool   `json:"inCycle"`
+	From     string           `json:"from"`
+	To       string           `json:"to"`
+	InCycle  bool             `json:"inCycle"`
+	Relation string           `json:"relation,omitempty"`
+	Imports  []jsonEdgeImport `json:"imports,omitempty"`
+}
+
+type jsonEdgeImport struct {
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	Specifier string `json:"specifier"`
 }
 
 type jsonGraphCycle struct {
@@ -81,11 +90,21 @@ func (f jsonGraphFormatter) Format(g depgraph.FileDependencyGraph, label string)
 		sort.Strings(deps)
 		for _, dep := range deps {
 			edgeMetadata := g.Meta.Edges[depgraph.FileEdge{From: source, To: dep}]
-			edges = append(edges, jsonGraphEdge{
-				From:    source,
-				To:      dep,
-				InCycle: edgeMetadata.InCycle,
-			})
+			edge := jsonGraphEdge{
+				From:     source,
+				To:       dep,
+				InCycle:  edgeMetadata.InCycle,
+				Relation: edgeMetadata.Relation,
+			}
+			for _, location := range edgeMetadata.Imports {
+				edge.Imports = append(edge.Imports, jsonEdgeImport{
+					File:      location.File,
+					Line:      location.Line,
+					Column:    location.Column,
+					Specifier: location.Specifier,
+				})
+			}
+			edges = append(edges, edge)
 		}
 	}
 
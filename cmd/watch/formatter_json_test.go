@@ -66,3 +66,20 @@ func TestJSONGraphFormatter_Format_Cycles(t *testing.T) {
 	g := testhelpers.JSONGoldie(t)
 	g.Assert(t, t.Name(), []byte(output))
 }
+
+func TestJSONGraphFormatter_Format_EdgeImports(t *testing.T) {
+	graph := testJSONFileGraph(t, map[string][]string{
+		"/project/app.ts":  {"/project/user.ts"},
+		"/project/user.ts": {},
+	}, nil)
+	depgraph.AttachImportLocations(graph, depgraph.ImportIndex{
+		{From: "/project/app.ts", To: "/project/user.ts"}: {{File: "/project/app.ts", Line: 2, Column: 1, Specifier: "./user"}},
+	})
+
+	formatter := jsonGraphFormatter{}
+	output, err := formatter.Format(graph, "imports-label")
+	require.NoError(t, err)
+
+	g := testhelpers.JSONGoldie(t)
+	g.Assert(t, t.Name(), []byte(output))
+}
@@ -35,7 +35,8 @@ func buildDOTGraph(repoPath string, opts *watchOptions) (string, error) {
 
 	contentReader := vcs.FilesystemContentReader()
 
-	graph, err := depgraph.BuildDependencyGraph(filePaths, contentReader)
+	built, err := depgraph.BuildDependencyGraphWithOptions(filePaths, contentReader, opts.buildOptions)
+	graph, relations := built.Graph, built.Relations
 	if err != nil {
 		return "", fmt.Errorf("failed to build dependency graph: %w", err)
 	}
@@ -46,6 +47,10 @@ func buildDOTGraph(repoPath string, opts *watchOptions) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to build file graph metadata: %w", err)
 	}
+	depgraph.AttachEdgeRelations(fileGraph, relations)
+	if err := depgraph.AttachCycleBreaks(fileGraph); err != nil {
+		return "", fmt.Errorf("failed to suggest cycle breaks: %w", err)
+	}
 
 	formatter, err := formatters.NewFormatter("dot")
 	if err != nil {
@@ -12,3 +12,9 @@ var distFS embed.FS
 func getDistFS() (fs.FS, error) {
 	return fs.Sub(distFS, "dist")
 }
+
+// ViewerFS returns the built viewer (index.html and assets/) so other commands can embed it,
+// such as the self-contained HTML reports written by show.
+func ViewerFS() (fs.FS, error) {
+	return getDistFS()
+}
@@ -1,6 +1,9 @@
 package watch
 
-import "github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+import (
+	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+)
 
 type watchOptions struct {
 	repoPath   string
@@ -10,6 +13,8 @@ type watchOptions struct {
 	excludeExt string
 	includes   []string
 	excludes   []string
+	// buildOptions configures optional dependency-graph analysis.
+	buildOptions depgraph.BuildOptions
 }
 
 func defaultWatchOptions() *watchOptions {
@@ -12,6 +12,7 @@ import (
 	"syscall"
 
 	"github.com/LegacyCodeHQ/clarity/cmd/show/formatters"
+	"github.com/LegacyCodeHQ/clarity/internal/graphflags"
 	"github.com/LegacyCodeHQ/clarity/internal/mcplogdlog"
 	"github.com/spf13/cobra"
 )
@@ -46,6 +47,7 @@ func NewCommand() *cobra.Command {
 		"d",
 		opts.direction,
 		fmt.Sprintf("Graph direction (%s)", formatters.SupportedDirections()))
+	graphflags.Register(cmd, &opts.buildOptions)
 
 	return cmd
 }
@@ -68,6 +70,7 @@ func runWatch(cmd *cobra.Command, opts *watchOptions) error {
 		return fmt.Errorf("failed to resolve repo path: %w", err)
 	}
 	repoPath = absRepoPath
+	graphflags.ResolveRoots(&opts.buildOptions, repoPath)
 
 	if direction, ok := formatters.ParseDirection(opts.direction); !ok {
 		return fmt.Errorf("unknown direction: %s (valid options: %s)", opts.direction, formatters.SupportedDirections())
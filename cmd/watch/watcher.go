@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/LegacyCodeHQ/clarity/depgraph/registry"
+	"github.com/LegacyCodeHQ/clarity/internal/repofiles"
 	"github.com/LegacyCodeHQ/clarity/vcs/git"
 	"github.com/fsnotify/fsnotify"
 )
@@ -18,18 +19,6 @@ import (
 const debounceInterval = 300 * time.Millisecond
 const gitStatePollInterval = 500 * time.Millisecond
 
-var skippedDirs = map[string]bool{
-	".git":         true,
-	"node_modules": true,
-	"target":       true,
-	".dart_tool":   true,
-	"build":        true,
-	"__pycache__":  true,
-	".gradle":      true,
-	".idea":        true,
-	".vscode":      true,
-}
-
 func watchAndRebuild(ctx context.Context, repoPath string, opts *watchOptions, b *broker) error {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
@@ -172,7 +161,7 @@ func addWatchDirsWithAdder(root string, add watchDirAdder) error {
 			isDir = info.IsDir()
 		}
 		if isDir {
-			if skippedDirs[d.Name()] {
+			if repofiles.IsSkippedDir(d.Name()) {
 				return filepath.SkipDir
 			}
 			if err := add(path); err != nil {
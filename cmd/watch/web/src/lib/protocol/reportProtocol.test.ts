@@ -0,0 +1,44 @@
+import { describe, it, expect } from 'vitest';
+import { normalizeReportPayload, parseReportPayload, reportStreamPayload } from './reportProtocol';
+
+describe('normalizeReportPayload', () => {
+  it('keeps title, dot and well-formed cycles', () => {
+    const report = normalizeReportPayload({
+      title: 'clarity • abc1234',
+      dot: 'digraph dependencies {}',
+      cycles: [['a.go', 'b.go'], 'not-a-cycle', [1, 'c.go'], []],
+    });
+
+    expect(report).toEqual({
+      title: 'clarity • abc1234',
+      dot: 'digraph dependencies {}',
+      cycles: [['a.go', 'b.go'], ['c.go']],
+    });
+  });
+
+  it('rejects payloads without a dot graph', () => {
+    expect(normalizeReportPayload({ title: 'x' })).toBeNull();
+    expect(normalizeReportPayload(null)).toBeNull();
+  });
+});
+
+describe('parseReportPayload', () => {
+  it('returns null for missing or invalid JSON', () => {
+    expect(parseReportPayload(null)).toBeNull();
+    expect(parseReportPayload('{')).toBeNull();
+  });
+
+  it('defaults missing cycles to an empty list', () => {
+    expect(parseReportPayload('{"dot":"digraph {}"}')).toEqual({ title: '', dot: 'digraph {}', cycles: [] });
+  });
+});
+
+describe('reportStreamPayload', () => {
+  it('exposes the report as the only live snapshot', () => {
+    const payload = reportStreamPayload({ title: '', dot: 'digraph {}', cycles: [] });
+
+    expect(payload.workingSnapshots).toHaveLength(1);
+    expect(payload.workingSnapshots[0].dot).toBe('digraph {}');
+    expect(payload.pastCollections).toEqual([]);
+  });
+});
@@ -0,0 +1,66 @@
+/**
+ * Type definitions and normalization for static HTML reports written by `clarity show -f html`.
+ * A report embeds a single graph instead of streaming snapshots from the watch server.
+ */
+
+import type { GraphStreamPayload } from './viewerProtocol';
+
+export const REPORT_ELEMENT_ID = 'clarity-report';
+
+export interface ReportPayload {
+  title: string;
+  dot: string;
+  cycles: string[][];
+}
+
+/**
+ * Normalizes the embedded report JSON.
+ * Returns null when the payload has no DOT graph to render.
+ */
+export function normalizeReportPayload(payload: unknown): ReportPayload | null {
+  if (!payload || typeof payload !== 'object') {
+    return null;
+  }
+  const p = payload as Record<string, unknown>;
+  if (typeof p.dot !== 'string') {
+    return null;
+  }
+
+  return {
+    title: typeof p.title === 'string' ? p.title : '',
+    dot: p.dot,
+    cycles: Array.isArray(p.cycles)
+      ? p.cycles
+          .filter((cycle): cycle is unknown[] => Array.isArray(cycle))
+          .map((cycle) => cycle.filter((node): node is string => typeof node === 'string'))
+          .filter((cycle) => cycle.length > 0)
+      : [],
+  };
+}
+
+/**
+ * Parses the report JSON embedded in the page, if any.
+ */
+export function parseReportPayload(raw: string | null | undefined): ReportPayload | null {
+  if (!raw) {
+    return null;
+  }
+  try {
+    return normalizeReportPayload(JSON.parse(raw));
+  } catch {
+    return null;
+  }
+}
+
+/**
+ * Wraps a report as a stream payload with a single live snapshot,
+ * so the report reuses the watch viewer state machine.
+ */
+export function reportStreamPayload(report: ReportPayload): GraphStreamPayload {
+  return {
+    workingSnapshots: [{ id: 1, timestamp: new Date(0).toISOString(), dot: report.dot }],
+    pastCollections: [],
+    latestWorkingId: 1,
+    latestPastCollectionId: 0,
+  };
+}
@@ -0,0 +1,102 @@
+import { describe, it, expect } from 'vitest';
+import {
+  IDENTITY_TRANSFORM,
+  MAX_ZOOM,
+  formatCycle,
+  highlightFor,
+  matchNodes,
+  panBy,
+  parseEdgeTitle,
+  reachableNodes,
+  buildAdjacency,
+  zoomAt,
+  type GraphEdge,
+} from './graphInteraction';
+
+const EDGES: GraphEdge[] = [
+  { from: 'main.go', to: 'server.go' },
+  { from: 'server.go', to: 'store.go' },
+  { from: 'cli.go', to: 'server.go' },
+  { from: 'other.go', to: 'util.go' },
+];
+
+describe('parseEdgeTitle', () => {
+  it('splits Graphviz edge titles', () => {
+    expect(parseEdgeTitle('web/->lib/')).toEqual({ from: 'web/', to: 'lib/' });
+  });
+
+  it('rejects titles without both endpoints', () => {
+    expect(parseEdgeTitle('main.go')).toBeNull();
+    expect(parseEdgeTitle('->lib/')).toBeNull();
+    expect(parseEdgeTitle('web/->')).toBeNull();
+  });
+});
+
+describe('reachableNodes', () => {
+  it('includes the start node only when it lies on a cycle', () => {
+    const acyclic = buildAdjacency(EDGES);
+    expect([...reachableNodes(acyclic.dependencies, 'main.go')].sort()).toEqual(['server.go', 'store.go']);
+
+    const cyclic = buildAdjacency([{ from: 'a', to: 'b' }, { from: 'b', to: 'a' }]);
+    expect([...reachableNodes(cyclic.dependencies, 'a')].sort()).toEqual(['a', 'b']);
+  });
+});
+
+describe('highlightFor', () => {
+  it('returns null without focus', () => {
+    expect(highlightFor(EDGES, null)).toBeNull();
+  });
+
+  it('highlights transitive dependencies and dependents of a node', () => {
+    const highlight = highlightFor(EDGES, { kind: 'node', node: 'server.go' })!;
+
+    expect([...highlight.nodes].sort()).toEqual(['cli.go', 'main.go', 'server.go', 'store.go']);
+    expect([...highlight.edges].sort()).toEqual(['cli.go->server.go', 'main.go->server.go', 'server.go->store.go']);
+  });
+
+  it('highlights the nodes and edges of a cycle', () => {
+    const highlight = highlightFor(EDGES, { kind: 'cycle', index: 0 }, [['a.go', 'b.go', 'c.go']])!;
+
+    expect([...highlight.nodes]).toEqual(['a.go', 'b.go', 'c.go']);
+    expect([...highlight.edges]).toEqual(['a.go->b.go', 'b.go->c.go', 'c.go->a.go']);
+  });
+
+  it('ignores unknown cycles', () => {
+    expect(highlightFor(EDGES, { kind: 'cycle', index: 3 }, [])).toBeNull();
+  });
+});
+
+describe('matchNodes', () => {
+  it('matches case-insensitive substrings', () => {
+    expect(matchNodes(['Server.go', 'store.go', 'main.go'], ' S')).toEqual(['Server.go', 'store.go']);
+  });
+
+  it('matches nothing for an empty query', () => {
+    expect(matchNodes(['main.go'], '  ')).toEqual([]);
+  });
+});
+
+describe('formatCycle', () => {
+  it('closes the cycle', () => {
+    expect(formatCycle(['a.go', 'b.go'])).toBe('a.go → b.go → a.go');
+  });
+});
+
+describe('zoomAt', () => {
+  it('keeps the zoom point fixed on screen', () => {
+    const zoomed = zoomAt(IDENTITY_TRANSFORM, 2, 100, 50);
+
+    expect(zoomed).toEqual({ x: -100, y: -50, scale: 2 });
+    expect((100 - zoomed.x) / zoomed.scale).toBe(100);
+  });
+
+  it('clamps the scale', () => {
+    expect(zoomAt(IDENTITY_TRANSFORM, 100, 0, 0).scale).toBe(MAX_ZOOM);
+  });
+});
+
+describe('panBy', () => {
+  it('offsets the translation', () => {
+    expect(panBy({ x: 1, y: 2, scale: 3 }, 10, -5)).toEqual({ x: 11, y: -3, scale: 3 });
+  });
+});
@@ -0,0 +1,161 @@
+/**
+ * Pure helpers for interacting with a rendered graph: pan/zoom transforms,
+ * node search and dependency highlighting.
+ * The component maps Graphviz SVG elements to these node and edge names.
+ */
+
+export interface GraphEdge {
+  from: string;
+  to: string;
+}
+
+export interface GraphAdjacency {
+  dependencies: Map<string, string[]>;
+  dependents: Map<string, string[]>;
+}
+
+export type GraphFocus =
+  | { kind: 'node'; node: string }
+  | { kind: 'cycle'; index: number };
+
+export interface GraphHighlight {
+  nodes: Set<string>;
+  edges: Set<string>;
+}
+
+export interface ViewTransform {
+  x: number;
+  y: number;
+  scale: number;
+}
+
+export const MIN_ZOOM = 0.1;
+export const MAX_ZOOM = 8;
+export const IDENTITY_TRANSFORM: ViewTransform = { x: 0, y: 0, scale: 1 };
+
+/**
+ * Graphviz titles each edge group "from->to".
+ */
+export function parseEdgeTitle(title: string): GraphEdge | null {
+  const separator = title.indexOf('->');
+  if (separator <= 0 || separator + 2 >= title.length) {
+    return null;
+  }
+  return { from: title.slice(0, separator), to: title.slice(separator + 2) };
+}
+
+export function edgeKey(edge: GraphEdge): string {
+  return `${edge.from}->${edge.to}`;
+}
+
+export function buildAdjacency(edges: GraphEdge[]): GraphAdjacency {
+  const dependencies = new Map<string, string[]>();
+  const dependents = new Map<string, string[]>();
+  for (const edge of edges) {
+    dependencies.set(edge.from, [...(dependencies.get(edge.from) ?? []), edge.to]);
+    dependents.set(edge.to, [...(dependents.get(edge.to) ?? []), edge.from]);
+  }
+  return { dependencies, dependents };
+}
+
+/**
+ * Returns every node reachable from start, excluding start unless it lies on a cycle.
+ */
+export function reachableNodes(neighbors: Map<string, string[]>, start: string): Set<string> {
+  const visited = new Set<string>();
+  const queue = [...(neighbors.get(start) ?? [])];
+  while (queue.length > 0) {
+    const node = queue.shift()!;
+    if (visited.has(node)) {
+      continue;
+    }
+    visited.add(node);
+    queue.push(...(neighbors.get(node) ?? []));
+  }
+  return visited;
+}
+
+/**
+ * Computes the nodes and edges to emphasize for the focused node or cycle.
+ * A focused node highlights its transitive dependencies and dependents.
+ */
+export function highlightFor(
+  edges: GraphEdge[],
+  focus: GraphFocus | null,
+  cycles: string[][] = []
+): GraphHighlight | null {
+  if (!focus) {
+    return null;
+  }
+
+  if (focus.kind === 'cycle') {
+    const cycle = cycles[focus.index];
+    if (!cycle || cycle.length === 0) {
+      return null;
+    }
+    const cycleEdges = new Set<string>();
+    cycle.forEach((node, i) => {
+      cycleEdges.add(edgeKey({ from: node, to: cycle[(i + 1) % cycle.length] }));
+    });
+    return { nodes: new Set(cycle), edges: cycleEdges };
+  }
+
+  const adjacency = buildAdjacency(edges);
+  const dependencies = reachableNodes(adjacency.dependencies, focus.node);
+  const dependents = reachableNodes(adjacency.dependents, focus.node);
+  const downstream = new Set([focus.node, ...dependencies]);
+  const upstream = new Set([focus.node, ...dependents]);
+
+  const highlightedEdges = new Set<string>();
+  for (const edge of edges) {
+    const isDependencyEdge = downstream.has(edge.from) && dependencies.has(edge.to);
+    const isDependentEdge = dependents.has(edge.from) && upstream.has(edge.to);
+    if (isDependencyEdge || isDependentEdge) {
+      highlightedEdges.add(edgeKey(edge));
+    }
+  }
+
+  return { nodes: new Set([...downstream, ...upstream]), edges: highlightedEdges };
+}
+
+/**
+ * Case-insensitive substring search over node names. An empty query matches nothing.
+ */
+export function matchNodes(nodes: string[], query: string): string[] {
+  const needle = query.trim().toLowerCase();
+  if (needle === '') {
+    return [];
+  }
+  return nodes.filter((node) => node.toLowerCase().includes(needle));
+}
+
+/**
+ * Formats a cycle as "a → b → a".
+ */
+export function formatCycle(cycle: string[]): string {
+  if (cycle.length === 0) {
+    return '';
+  }
+  return [...cycle, cycle[0]].join(' → ');
+}
+
+/**
+ * Zooms by factor while keeping the point (px, py) fixed on screen.
+ */
+export function zoomAt(transform: ViewTransform, factor: number, px: number, py: number): ViewTransform {
+  const scale = Math.min(MAX_ZOOM, Math.max(MIN_ZOOM, transform.scale * factor));
+  const ratio = scale / transform.scale;
+  return {
+    x: px - (px - transform.x) * ratio,
+    y: py - (py - transform.y) * ratio,
+    scale,
+  };
+}
+
+export function panBy(transform: ViewTransform, dx: number, dy: number): ViewTransform {
+  return { ...transform, x: transform.x + dx, y: transform.y + dy };
+}
+
+export function transformCSS(transform: ViewTransform): string {
+  return `translate(${transform.x}px, ${transform.y}px) scale(${transform.scale})`;
+}
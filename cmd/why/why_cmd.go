@@ -1,18 +1,19 @@
 package why
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
-	"io/fs"
 	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/LegacyCodeHQ/clarity/cmd/show"
 	"github.com/LegacyCodeHQ/clarity/depgraph"
-	"github.com/LegacyCodeHQ/clarity/depgraph/registry"
+	"github.com/LegacyCodeHQ/clarity/internal/graphflags"
+	"github.com/LegacyCodeHQ/clarity/internal/repofiles"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 	"github.com/spf13/cobra"
 )
@@ -21,12 +22,20 @@ const (
 	formatText    = "text"
 	formatDOT     = "dot"
 	formatMermaid = "mermaid"
+	formatJSON    = "json"
 )
 
 type whyOptions struct {
 	outputFormat string
 	repoPath     string
 	allowOutside bool
+	buildOptions depgraph.BuildOptions
+}
+
+type whyReport struct {
+	From        string             `json:"from"`
+	To          string             `json:"to"`
+	Connections []directConnection `json:"connections"`
 }
 
 type directConnection struct {
@@ -35,6 +44,13 @@ type directConnection struct {
 	Type    string         `json:"type"`
 	Members []memberSymbol `json:"members,omitempty"`
 	Calls   []memberUsage  `json:"calls,omitempty"`
+	Imports []importSite   `json:"imports,omitempty"`
+}
+
+type importSite struct {
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	Specifier string `json:"specifier"`
 }
 
 type memberUsage struct {
@@ -91,6 +107,7 @@ func NewCommand() *cobra.Command {
 		fmt.Sprintf("Output format (%s)", supportedFormats()))
 	cmd.Flags().StringVarP(&opts.repoPath, "repo", "r", "", "Git repository path (default: current directory)")
 	cmd.Flags().BoolVar(&opts.allowOutside, "allow-outside-repo", false, "Allow input paths outside the repo root")
+	graphflags.Register(cmd, &opts.buildOptions)
 
 	return cmd
 }
@@ -110,6 +127,7 @@ func runWhy(cmd *cobra.Command, opts *whyOptions, fromArg, toArg string) error {
 		return fmt.Errorf("failed to create path resolver: %w", err)
 	}
 	repoPath = pathResolver.BaseDir()
+	graphflags.ResolveRoots(&opts.buildOptions, repoPath)
 
 	fromPath, err := pathResolver.Resolve(show.RawPath(fromArg))
 	if err != nil {
@@ -120,7 +138,7 @@ func runWhy(cmd *cobra.Command, opts *whyOptions, fromArg, toArg string) error {
 		return fmt.Errorf("failed to resolve to file %q: %w", toArg, err)
 	}
 
-	filePaths, err := collectSupportedFiles(repoPath)
+	filePaths, err := repofiles.CollectSupported(repoPath)
 	if err != nil {
 		return fmt.Errorf("failed to collect files from repository: %w", err)
 	}
@@ -128,7 +146,9 @@ func runWhy(cmd *cobra.Command, opts *whyOptions, fromArg, toArg string) error {
 		return fmt.Errorf("no supported files found in repository")
 	}
 
-	graphData, err := depgraph.BuildDependencyGraph(filePaths, vcs.FilesystemContentReader())
+	opts.buildOptions.LocateImports = true
+	built, err := depgraph.BuildDependencyGraphWithOptions(filePaths, vcs.FilesystemContentReader(), opts.buildOptions)
+	graphData, imports := built.Graph, built.Imports
 	if err != nil {
 		return fmt.Errorf("failed to build dependency graph: %w", err)
 	}
@@ -145,6 +165,7 @@ func runWhy(cmd *cobra.Command, opts *whyOptions, fromArg, toArg string) error {
 		return err
 	}
 	enrichMembers(connections)
+	attachImportSites(connections, imports)
 
 	output, err := formatOutput(opts.outputFormat, repoPath, fromPath.String(), toPath.String(), connections)
 	if err != nil {
@@ -155,30 +176,6 @@ func runWhy(cmd *cobra.Command, opts *whyOptions, fromArg, toArg string) error {
 	return nil
 }
 
-func collectSupportedFiles(root string) ([]string, error) {
-	files := make([]string, 0, 256)
-	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
-		if walkErr != nil {
-			return walkErr
-		}
-		if d.IsDir() {
-			if d.Name() == ".git" {
-				return filepath.SkipDir
-			}
-			return nil
-		}
-
-		if registry.IsSupportedLanguageExtension(filepath.Ext(path)) {
-			files = append(files, path)
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	return files, nil
-}
-
 func findDirectConnections(g depgraph.DependencyGraph, fromPath, toPath string) ([]directConnection, error) {
 	var connections []directConnection
 
@@ -209,6 +206,20 @@ func findDirectConnections(g depgraph.DependencyGraph, fromPath, toPath string)
 	return connections, nil
 }
 
+// attachImportSites records the import statements behind each connection, which is the only
+// detail available for languages without member analysis.
+func attachImportSites(connections []directConnection, imports depgraph.ImportIndex) {
+	for i := range connections {
+		for _, location := range imports[depgraph.FileEdge{From: connections[i].From, To: connections[i].To}] {
+			connections[i].Imports = append(connections[i].Imports, importSite{
+				Line:      location.Line,
+				Column:    location.Column,
+				Specifier: location.Specifier,
+			})
+		}
+	}
+}
+
 func containsPath(paths []string, target string) bool {
 	for _, p := range paths {
 		if p == target {
@@ -226,14 +237,16 @@ func formatOutput(format, repoRoot, fromPath, toPath string, connections []direc
 		return formatDOTOutput(repoRoot, fromPath, toPath, connections), nil
 	case formatMermaid:
 		return formatMermaidOutput(repoRoot, fromPath, toPath, connections), nil
+	case formatJSON:
+		return formatJSONOutput(repoRoot, fromPath, toPath, connections)
 	default:
 		return "", fmt.Errorf("unknown format: %s (valid options: %s)", format, supportedFormats())
 	}
 }
 
 func formatTextOutput(repoRoot, fromPath, toPath string, connections []directConnection) string {
-	fromDisplay := displayPath(repoRoot, fromPath)
-	toDisplay := displayPath(repoRoot, toPath)
+	fromDisplay := repofiles.DisplayPath(repoRoot, fromPath)
+	toDisplay := repofiles.DisplayPath(repoRoot, toPath)
 
 	if len(connections) == 0 {
 		return fmt.Sprintf("No immediate dependency between %s and %s.", fromDisplay, toDisplay)
@@ -243,7 +256,13 @@ func formatTextOutput(repoRoot, fromPath, toPath string, connections []directCon
 		fmt.Sprintf("Direct connection(s) between %s and %s:", fromDisplay, toDisplay),
 	}
 	for _, c := range connections {
-		lines = append(lines, fmt.Sprintf("- %s depends on %s", displayPath(repoRoot, c.From), displayPath(repoRoot, c.To)))
+		lines = append(lines, fmt.Sprintf("- %s depends on %s", repofiles.DisplayPath(repoRoot, c.From), repofiles.DisplayPath(repoRoot, c.To)))
+		if len(c.Imports) > 0 {
+			lines = append(lines, "  imports:")
+			for _, site := range c.Imports {
+				lines = append(lines, fmt.Sprintf("    - %s:%d:%d %q", repofiles.DisplayPath(repoRoot, c.From), site.Line, site.Column, site.Specifier))
+			}
+		}
 		if len(c.Members) > 0 {
 			labels := make([]string, 0, len(c.Members))
 			for _, member := range c.Members {
@@ -261,6 +280,26 @@ func formatTextOutput(repoRoot, fromPath, toPath string, connections []directCon
 	return strings.Join(lines, "\n")
 }
 
+// formatJSONOutput reports paths relative to the repository root, like the other formats.
+func formatJSONOutput(repoRoot, fromPath, toPath string, connections []directConnection) (string, error) {
+	report := whyReport{
+		From:        repofiles.DisplayPath(repoRoot, fromPath),
+		To:          repofiles.DisplayPath(repoRoot, toPath),
+		Connections: make([]directConnection, 0, len(connections)),
+	}
+	for _, c := range connections {
+		c.From = repofiles.DisplayPath(repoRoot, c.From)
+		c.To = repofiles.DisplayPath(repoRoot, c.To)
+		report.Connections = append(report.Connections, c)
+	}
+
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
 func formatDOTOutput(repoRoot, fromPath, toPath string, connections []directConnection) string {
 	var b strings.Builder
 	b.WriteString("digraph G {\n")
@@ -274,8 +313,8 @@ func formatDOTOutput(repoRoot, fromPath, toPath string, connections []directConn
 	}
 
 	if !hasMemberDetails {
-		b.WriteString(fmt.Sprintf("  %q [label=%q, shape=box];\n", fromPath, displayPath(repoRoot, fromPath)))
-		b.WriteString(fmt.Sprintf("  %q [label=%q, shape=box];\n", toPath, displayPath(repoRoot, toPath)))
+		b.WriteString(fmt.Sprintf("  %q [label=%q, shape=box];\n", fromPath, repofiles.DisplayPath(repoRoot, fromPath)))
+		b.WriteString(fmt.Sprintf("  %q [label=%q, shape=box];\n", toPath, repofiles.DisplayPath(repoRoot, toPath)))
 		for _, c := range connections {
 			b.WriteString(fmt.Sprintf("  %q -> %q;\n", c.From, c.To))
 		}
@@ -290,8 +329,8 @@ func formatDOTOutput(repoRoot, fromPath, toPath string, connections []directConn
 
 		callerClusterID := fmt.Sprintf("cluster_caller_%d", connIdx)
 		calleeClusterID := fmt.Sprintf("cluster_callee_%d", connIdx)
-		callerDisplay := displayPath(repoRoot, c.From)
-		calleeDisplay := displayPath(repoRoot, c.To)
+		callerDisplay := repofiles.DisplayPath(repoRoot, c.From)
+		calleeDisplay := repofiles.DisplayPath(repoRoot, c.To)
 
 		b.WriteString(fmt.Sprintf("  subgraph %s {\n", callerClusterID))
 		b.WriteString(fmt.Sprintf("    label=%q;\n", callerDisplay))
@@ -354,8 +393,8 @@ func formatMermaidOutput(repoRoot, fromPath, toPath string, connections []direct
 	}
 
 	if !hasMemberDetails {
-		b.WriteString(fmt.Sprintf("  n0[%q]\n", displayPath(repoRoot, fromPath)))
-		b.WriteString(fmt.Sprintf("  n1[%q]\n", displayPath(repoRoot, toPath)))
+		b.WriteString(fmt.Sprintf("  n0[%q]\n", repofiles.DisplayPath(repoRoot, fromPath)))
+		b.WriteString(fmt.Sprintf("  n1[%q]\n", repofiles.DisplayPath(repoRoot, toPath)))
 
 		for _, c := range connections {
 			fromNode := "n0"
@@ -374,8 +413,8 @@ func formatMermaidOutput(repoRoot, fromPath, toPath string, connections []direct
 			continue
 		}
 
-		callerDisplay := displayPath(repoRoot, c.From)
-		calleeDisplay := displayPath(repoRoot, c.To)
+		callerDisplay := repofiles.DisplayPath(repoRoot, c.From)
+		calleeDisplay := repofiles.DisplayPath(repoRoot, c.To)
 		callerGroupID := fmt.Sprintf("sg_caller_%d", connIdx)
 		calleeGroupID := fmt.Sprintf("sg_callee_%d", connIdx)
 		callerNodeID := fmt.Sprintf("caller_%d", connIdx)
@@ -693,17 +732,9 @@ func sanitizeMermaidID(input string) string {
 	return b.String()
 }
 
-func displayPath(repoRoot, absolutePath string) string {
-	rel, err := filepath.Rel(repoRoot, absolutePath)
-	if err != nil || rel == "." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || rel == ".." {
-		return absolutePath
-	}
-	return rel
-}
-
 func isSupportedFormat(format string) bool {
 	switch strings.ToLower(format) {
-	case formatText, formatDOT, formatMermaid:
+	case formatText, formatDOT, formatMermaid, formatJSON:
 		return true
 	default:
 		return false
@@ -711,5 +742,5 @@ func isSupportedFormat(format string) bool {
 }
 
 func supportedFormats() string {
-	return strings.Join([]string{formatText, formatDOT, formatMermaid}, ", ")
+	return strings.Join([]string{formatText, formatDOT, formatMermaid, formatJSON}, ", ")
 }
@@ -36,6 +36,9 @@ func TestWhyCommand_TextDirectDependency(t *testing.T) {
 	if !strings.Contains(output, "from.js depends on to.js") {
 		t.Fatalf("expected direct dependency in output, got:\n%s", output)
 	}
+	if !strings.Contains(output, "  imports:\n    - from.js:1:1 \"./to.js\"") {
+		t.Fatalf("expected import location in output, got:\n%s", output)
+	}
 }
 
 func TestWhyCommand_TextNoDirectDependency(t *testing.T) {
@@ -128,6 +131,52 @@ func TestWhyCommand_MermaidFormat(t *testing.T) {
 	}
 }
 
+func TestWhyCommand_JSONFormat(t *testing.T) {
+	repoDir := t.TempDir()
+	fromPath := filepath.Join(repoDir, "from.js")
+	toPath := filepath.Join(repoDir, "to.js")
+
+	if err := os.WriteFile(fromPath, []byte("import { x } from './to.js'\n"), 0o644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	if err := os.WriteFile(toPath, []byte("export const x = 1\n"), 0o644); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"-r", repoDir, "-f", "json", "from.js", "to.js"})
+
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("cmd.Execute() error = %v", err)
+	}
+
+	want := `{
+  "from": "from.js",
+  "to": "to.js",
+  "connections": [
+    {
+      "from": "from.js",
+      "to": "to.js",
+      "type": "dependency",
+      "imports": [
+        {
+          "line": 1,
+          "column": 1,
+          "specifier": "./to.js"
+        }
+      ]
+    }
+  ]
+}
+`
+	if stdout.String() != want {
+		t.Fatalf("unexpected JSON output:\n%s\nwant:\n%s", stdout.String(), want)
+	}
+}
+
 func TestWhyCommand_TextDirectDependency_CJS(t *testing.T) {
 	repoDir := t.TempDir()
 	fromPath := filepath.Join(repoDir, "from.cjs")
@@ -7,6 +7,7 @@ import (
 
 	graphlib "github.com/dominikbraun/graph"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/registry"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 )
 
@@ -16,7 +17,7 @@ import (
 // Only dependencies that are in the supplied file list are included in the graph.
 // The contentReader function is used to read file contents (from filesystem, git commit, etc.)
 func BuildDependencyGraph(filePaths []string, contentReader vcs.ContentReader) (DependencyGraph, error) {
-	ctx, err := buildDependencyGraphContext(filePaths, contentReader)
+	ctx, err := buildDependencyGraphContext(filePaths, contentReader, BuildOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -24,10 +25,59 @@ func BuildDependencyGraph(filePaths []string, contentReader vcs.ContentReader) (
 	return BuildDependencyGraphWithResolver(filePaths, NewDefaultDependencyResolver(ctx, contentReader))
 }
 
+// BuildOptions configures optional analysis. The zero value builds the same graph as BuildDependencyGraph.
+type BuildOptions = registry.BuildOptions
+
+// BuildResult is a dependency graph together with what was learned while building it.
+type BuildResult struct {
+	Graph DependencyGraph
+	// Imports records the import statements behind each edge, for languages that can locate them.
+	// It is nil unless BuildOptions.LocateImports is set.
+	Imports ImportIndex
+	// Packages maps each Java, Kotlin, Scala and Groovy file to the package it declares.
+	Packages map[string]string
+	// Relations records the edges that are not imports, such as generated code linked to its
+	// .proto file.
+	Relations RelationIndex
+}
+
+// BuildDependencyGraphWithOptions builds the graph with optional analysis enabled.
+func BuildDependencyGraphWithOptions(filePaths []string, contentReader vcs.ContentReader, opts BuildOptions) (BuildResult, error) {
+	ctx, err := buildDependencyGraphContext(filePaths, contentReader, opts)
+	if err != nil {
+		return BuildResult{}, err
+	}
+
+	var imports ImportIndex
+	if opts.LocateImports {
+		imports = make(ImportIndex)
+	}
+	graph, err := buildDependencyGraph(filePaths, NewDefaultDependencyResolver(ctx, contentReader), imports)
+	result := BuildResult{Graph: graph, Imports: imports}
+	if ctx.JVMIndex != nil {
+		result.Packages = ctx.JVMIndex.FilePackages
+	}
+	if err != nil {
+		return result, err
+	}
+	result.Relations, err = EdgeRelations(graph)
+	return result, err
+}
+
 // BuildDependencyGraphWithResolver builds a graph using the provided DependencyResolver implementation.
 func BuildDependencyGraphWithResolver(
 	filePaths []string,
 	dependencyResolver DependencyResolver,
+) (DependencyGraph, error) {
+	return buildDependencyGraph(filePaths, dependencyResolver, nil)
+}
+
+// buildDependencyGraph builds the graph and, when imports is non-nil and the resolver can locate
+// imports, records the import statements behind each edge.
+func buildDependencyGraph(
+	filePaths []string,
+	dependencyResolver DependencyResolver,
+	imports ImportIndex,
 ) (DependencyGraph, error) {
 	graph := NewDependencyGraph()
 
@@ -73,6 +123,10 @@ func BuildDependencyGraphWithResolver(
 				return nil, fmt.Errorf("failed to add graph edge %s -> %s: %w", absPath, dep, err)
 			}
 		}
+
+		if err := locateImports(dependencyResolver, imports, absPath, filePath, ext, projectImports); err != nil {
+			return nil, err
+		}
 	}
 
 	// Third pass: add intra-package dependencies for languages that need it.
@@ -83,6 +137,41 @@ func BuildDependencyGraphWithResolver(
 	return graph, nil
 }
 
+// locateImports records where absPath declares each of its project imports.
+func locateImports(
+	dependencyResolver DependencyResolver,
+	imports ImportIndex,
+	absPath, filePath, ext string,
+	projectImports []string,
+) error {
+	locator, ok := dependencyResolver.(ImportLocatingResolver)
+	if imports == nil || !ok || len(projectImports) == 0 {
+		return nil
+	}
+
+	references, err := locator.LocateProjectImports(absPath, filePath, ext)
+	if err != nil {
+		return err
+	}
+
+	dependencies := make(map[string]bool, len(projectImports))
+	for _, dep := range projectImports {
+		dependencies[dep] = true
+	}
+	for _, reference := range references {
+		if !dependencies[reference.Target] {
+			continue
+		}
+		imports.add(FileEdge{From: absPath, To: reference.Target}, ImportLocation{
+			File:      absPath,
+			Line:      reference.Line,
+			Column:    reference.Column,
+			Specifier: reference.Specifier,
+		})
+	}
+	return nil
+}
+
 // deduplicatePaths removes duplicate entries while preserving insertion order
 func deduplicatePaths(paths []string) []string {
 	seen := make(map[string]bool)
@@ -10,18 +10,30 @@ import (
 
 type dependencyGraphContext = registry.Context
 
-func buildDependencyGraphContext(filePaths []string, contentReader vcs.ContentReader) (*dependencyGraphContext, error) {
+func buildDependencyGraphContext(filePaths []string, contentReader vcs.ContentReader, opts BuildOptions) (*dependencyGraphContext, error) {
 	suppliedFiles, dirToFiles, javaFiles, kotlinFiles, goFiles, err := collectDependencyGraphFiles(filePaths)
 	if err != nil {
 		return nil, err
 	}
 
+	protoRoots := make([]string, 0, len(opts.ProtoRoots))
+	for _, root := range opts.ProtoRoots {
+		absRoot, err := filepath.Abs(root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve proto root %s: %w", root, err)
+		}
+		protoRoots = append(protoRoots, absRoot)
+	}
+	opts.ProtoRoots = protoRoots
+
 	return &dependencyGraphContext{
 		SuppliedFiles: suppliedFiles,
 		DirToFiles:    dirToFiles,
 		JavaFiles:     javaFiles,
 		KotlinFiles:   kotlinFiles,
 		GoFiles:       goFiles,
+		JVMIndex:      registry.BuildJVMIndex(suppliedFiles, contentReader),
+		Options:       opts,
 	}, nil
 }
 
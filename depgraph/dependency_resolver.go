@@ -12,6 +12,12 @@ type DependencyResolver interface {
 	FinalizeGraph(graph DependencyGraph) error
 }
 
+// ImportLocatingResolver is a DependencyResolver that can also report where project imports are declared.
+type ImportLocatingResolver interface {
+	DependencyResolver
+	LocateProjectImports(absPath, filePath, ext string) ([]registry.ImportReference, error)
+}
+
 type defaultDependencyResolver struct {
 	extensionResolvers map[string]registry.Resolver
 	resolvers          []registry.Resolver
@@ -52,6 +58,17 @@ func (b *defaultDependencyResolver) ResolveProjectImports(absPath, filePath, ext
 	return resolver.ResolveProjectImports(absPath, filePath, ext)
 }
 
+// LocateProjectImports reports import locations for languages whose resolver supports it,
+// and nothing for the others.
+func (b *defaultDependencyResolver) LocateProjectImports(absPath, filePath, ext string) ([]registry.ImportReference, error) {
+	locator, ok := b.extensionResolvers[ext].(registry.ImportLocator)
+	if !ok {
+		return nil, nil
+	}
+
+	return locator.LocateProjectImports(absPath, filePath, ext)
+}
+
 func (b *defaultDependencyResolver) FinalizeGraph(graph DependencyGraph) error {
 	for _, resolver := range b.resolvers {
 		if err := resolver.FinalizeGraph(graph); err != nil {
@@ -0,0 +1,40 @@
+package depgraph
+
+import "github.com/LegacyCodeHQ/clarity/depgraph/registry"
+
+// RelationGeneratedFrom tags an edge from generated code, such as *.pb.go, to the source it was
+// generated from.
+const RelationGeneratedFrom = registry.RelationGeneratedFrom
+
+// RelationIndex maps the edges that are not imports to their relation, such as RelationGeneratedFrom.
+type RelationIndex map[FileEdge]string
+
+// EdgeRelations collects the relation that resolvers tagged on the edges of g. Graphs rebuilt
+// from an adjacency list lose edge attributes, so the index is taken from the built graph.
+func EdgeRelations(g DependencyGraph) (RelationIndex, error) {
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, err
+	}
+
+	relations := make(RelationIndex)
+	for _, edge := range edges {
+		if relation := edge.Properties.Attributes[registry.EdgeRelationAttribute]; relation != "" {
+			relations[FileEdge{From: edge.Source, To: edge.Target}] = relation
+		}
+	}
+	return relations, nil
+}
+
+// AttachEdgeRelations records the relation of each edge of g in its edge metadata.
+// Edges missing from g are ignored, so the index may come from an unfiltered graph.
+func AttachEdgeRelations(g FileDependencyGraph, relations RelationIndex) {
+	for edge, relation := range relations {
+		md, ok := g.Meta.Edges[edge]
+		if !ok {
+			continue
+		}
+		md.Relation = relation
+		g.Meta.Edges[edge] = md
+	}
+}
@@ -0,0 +1,37 @@
+package depgraph_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachEdgeRelations_DropsRelationOfMixedGroupEdge(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/gen/a.pb.go":    {"/project/proto/a.proto"},
+		"/project/gen/b.pb.go":    {"/project/proto/b.proto"},
+		"/project/gen/client.go":  {"/project/proto/a.proto", "/project/api/handler.go"},
+		"/project/api/handler.go": {},
+		"/project/proto/a.proto":  {},
+		"/project/proto/b.proto":  {},
+	})
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, nil, nil)
+	require.NoError(t, err)
+
+	depgraph.AttachEdgeRelations(fileGraph, depgraph.RelationIndex{
+		{From: "/project/gen/a.pb.go", To: "/project/proto/a.proto"}:    depgraph.RelationGeneratedFrom,
+		{From: "/project/gen/b.pb.go", To: "/project/proto/b.proto"}:    depgraph.RelationGeneratedFrom,
+		{From: "/project/gen/missing.go", To: "/project/proto/a.proto"}: depgraph.RelationGeneratedFrom,
+	})
+	assert.Equal(t, depgraph.RelationGeneratedFrom, fileGraph.Meta.Edges[depgraph.FileEdge{From: "/project/gen/a.pb.go", To: "/project/proto/a.proto"}].Relation)
+	assert.Empty(t, fileGraph.Meta.Edges[depgraph.FileEdge{From: "/project/gen/client.go", To: "/project/proto/a.proto"}].Relation)
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, filepath.Dir)
+	require.NoError(t, err)
+
+	assert.Empty(t, grouped.Meta.Edges[depgraph.FileEdge{From: "/project/gen", To: "/project/proto"}].Relation,
+		"an import from client.go shares the group edge")
+}
@@ -26,6 +26,9 @@ type FileMetadata struct {
 	Stats     *vcs.FileStats
 	IsTest    bool
 	Extension string
+	// Members lists the files collapsed into this node when the graph is grouped.
+	// It is empty for plain file nodes.
+	Members []string
 }
 
 // FileEdge identifies a directed edge between two files.
@@ -37,11 +40,28 @@ type FileEdge struct {
 // EdgeMetadata holds metadata for a graph edge.
 type EdgeMetadata struct {
 	InCycle bool
+	// BreaksCycle is true when the edge is a suggested cycle break, see AttachCycleBreaks.
+	BreaksCycle bool
+	// Weight is the number of file edges collapsed into this edge when the graph is grouped.
+	// It is zero for plain file edges.
+	Weight int
+	// Imports lists the import statements behind the edge, when the language reports them.
+	// See AttachImportLocations.
+	Imports []ImportLocation
+	// Relation tags edges that are not imports, such as RelationGeneratedFrom. It is empty for
+	// imports. See AttachEdgeRelations.
+	Relation string
 }
 
 // FileCycle describes a representative cycle path for a cyclic SCC.
 type FileCycle struct {
 	Path []string
+	// Nodes lists every file in the SCC, sorted.
+	Nodes []string
+	// Breaks is a minimal set of edges whose removal breaks every cycle in the SCC,
+	// ranked by the number of cycles each edge takes part in. It is empty until
+	// AttachCycleBreaks is called.
+	Breaks []CycleBreak
 }
 
 // NewFileDependencyGraph creates a file-annotated graph from a dependency graph, optional file stats,
@@ -81,12 +101,7 @@ func NewFileDependencyGraph(g DependencyGraph, fileStats map[string]vcs.FileStat
 		}
 	}
 
-	cycles, cycleEdges := findCyclesAndCycleEdges(adjacency)
-	for edge := range cycleEdges {
-		edgeMetadata := edges[edge]
-		edgeMetadata.InCycle = true
-		edges[edge] = edgeMetadata
-	}
+	cycles := markCycleEdges(adjacency, edges)
 
 	return FileDependencyGraph{
 		Graph: g,
@@ -98,6 +113,24 @@ func NewFileDependencyGraph(g DependencyGraph, fileStats map[string]vcs.FileStat
 	}, nil
 }
 
+// markCycleEdges finds the cyclic SCCs of the graph and flags the edges inside them.
+func markCycleEdges(adjacency map[string][]string, edges map[FileEdge]EdgeMetadata) []FileCycle {
+	cycles, cycleEdges := findCyclesAndCycleEdges(adjacency)
+	for edge := range cycleEdges {
+		edgeMetadata := edges[edge]
+		edgeMetadata.InCycle = true
+		edges[edge] = edgeMetadata
+	}
+	return cycles
+}
+
+// FindCycles returns the cyclic SCCs of an adjacency list, in the same form as FileGraphMetadata.Cycles.
+// Breaks are left empty; see CycleBreaks.
+func FindCycles(adjacency map[string][]string) []FileCycle {
+	cycles, _ := findCyclesAndCycleEdges(adjacency)
+	return cycles
+}
+
 func findCyclesAndCycleEdges(adjacency map[string][]string) ([]FileCycle, map[FileEdge]bool) {
 	sccs := stronglyConnectedComponents(adjacency)
 	cycleEdges := make(map[FileEdge]bool)
@@ -126,8 +159,10 @@ func findCyclesAndCycleEdges(adjacency map[string][]string) ([]FileCycle, map[Fi
 		}
 
 		cyclePath := append([]string(nil), pathWithClosure[:len(pathWithClosure)-1]...)
-		cycles = append(cycles, FileCycle{Path: cyclePath})
-
+		cycles = append(cycles, FileCycle{
+			Path:  cyclePath,
+			Nodes: append([]string(nil), scc...),
+		})
 	}
 
 	return cycles, cycleEdges
@@ -0,0 +1,276 @@
+package depgraph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// cycleCountLimit caps the cycles counted through a single edge, since a large SCC can contain
+// exponentially many.
+const cycleCountLimit = 1000
+
+// cycleCountSteps bounds the search spent counting cycles through a single edge.
+const cycleCountSteps = 100000
+
+// CycleBreak is an edge suggested for removal to make the graph acyclic.
+type CycleBreak struct {
+	Edge FileEdge
+	// Cycles is the number of simple cycles that pass through the edge. Counting stops at
+	// cycleCountLimit, so large SCCs report a lower bound.
+	Cycles int
+}
+
+// CycleCountLabel renders the number of cycles through an edge, e.g. "1 cycle" or "3 cycles".
+func CycleCountLabel(count int) string {
+	if count == 1 {
+		return "1 cycle"
+	}
+	return fmt.Sprintf("%d cycles", count)
+}
+
+// FeedbackArcSet returns an approximately minimum set of edges whose removal makes the graph acyclic.
+// Edges are ranked by the number of cycles they take part in, most first.
+func FeedbackArcSet(adjacency map[string][]string) []CycleBreak {
+	var breaks []CycleBreak
+	for _, scc := range stronglyConnectedComponents(adjacency) {
+		if !isCyclicSCC(adjacency, scc) {
+			continue
+		}
+		breaks = append(breaks, sccFeedbackArcSet(adjacency, scc)...)
+	}
+	sortCycleBreaks(breaks)
+	return breaks
+}
+
+// CycleBreaks returns a minimal set of edges whose removal breaks every cycle in the SCC of cycle,
+// ranked by the number of cycles each edge takes part in.
+func CycleBreaks(adjacency map[string][]string, cycle FileCycle) []CycleBreak {
+	return sccFeedbackArcSet(adjacency, cycle.Nodes)
+}
+
+// AttachCycleBreaks fills in the Breaks of every cycle of g and flags those edges as BreaksCycle.
+// Counting the cycles through an edge is expensive in large SCCs, so graphs are built without
+// breaks and only the callers that report them pay for it.
+func AttachCycleBreaks(g FileDependencyGraph) error {
+	adjacency, err := AdjacencyList(g.Graph)
+	if err != nil {
+		return err
+	}
+
+	for i, cycle := range g.Meta.Cycles {
+		breaks := CycleBreaks(adjacency, cycle)
+		g.Meta.Cycles[i].Breaks = breaks
+		for _, cycleBreak := range breaks {
+			edgeMetadata := g.Meta.Edges[cycleBreak.Edge]
+			edgeMetadata.BreaksCycle = true
+			g.Meta.Edges[cycleBreak.Edge] = edgeMetadata
+		}
+	}
+	return nil
+}
+
+// sccFeedbackArcSet breaks the cycles of one strongly connected component. Nodes are ordered with
+// the Eades-Lin-Smyth heuristic and the edges pointing backwards in that order are removed. Edges that
+// no longer close a cycle once the others are removed are then restored, so the set is minimal.
+func sccFeedbackArcSet(adjacency map[string][]string, scc []string) []CycleBreak {
+	sub := inducedSubgraph(adjacency, scc)
+
+	var candidates []FileEdge
+	order := eadesOrder(sub, scc)
+	position := make(map[string]int, len(order))
+	for i, node := range order {
+		position[node] = i
+	}
+	for _, from := range scc {
+		for _, to := range sub[from] {
+			if position[from] >= position[to] {
+				candidates = append(candidates, FileEdge{From: from, To: to})
+			}
+		}
+	}
+
+	removed := make(map[FileEdge]bool, len(candidates))
+	for _, edge := range candidates {
+		removed[edge] = true
+	}
+	var breaks []CycleBreak
+	for _, edge := range candidates {
+		if edge.From != edge.To && !reachableWithout(sub, removed, edge.To, edge.From) {
+			delete(removed, edge)
+			continue
+		}
+		breaks = append(breaks, CycleBreak{Edge: edge, Cycles: countCyclesThrough(sub, edge)})
+	}
+
+	sortCycleBreaks(breaks)
+	return breaks
+}
+
+// inducedSubgraph returns the sorted, de-duplicated edges between the given nodes.
+func inducedSubgraph(adjacency map[string][]string, nodes []string) map[string][]string {
+	allowed := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		allowed[node] = true
+	}
+
+	sub := make(map[string][]string, len(nodes))
+	for _, from := range nodes {
+		seen := make(map[string]bool)
+		deps := []string{}
+		for _, to := range adjacency[from] {
+			if allowed[to] && !seen[to] {
+				seen[to] = true
+				deps = append(deps, to)
+			}
+		}
+		sort.Strings(deps)
+		sub[from] = deps
+	}
+	return sub
+}
+
+// eadesOrder orders the nodes so that few edges point backwards: sinks go last, sources go first,
+// and otherwise the node with the largest surplus of outgoing edges is placed next.
+func eadesOrder(sub map[string][]string, nodes []string) []string {
+	remaining := make(map[string]bool, len(nodes))
+	inDegree := make(map[string]int, len(nodes))
+	outDegree := make(map[string]int, len(nodes))
+	dependents := make(map[string][]string, len(nodes))
+	for _, from := range nodes {
+		remaining[from] = true
+	}
+	for _, from := range nodes {
+		for _, to := range sub[from] {
+			if from == to {
+				continue
+			}
+			outDegree[from]++
+			inDegree[to]++
+			dependents[to] = append(dependents[to], from)
+		}
+	}
+
+	remove := func(node string) {
+		delete(remaining, node)
+		for _, to := range sub[node] {
+			if to != node && remaining[to] {
+				inDegree[to]--
+			}
+		}
+		for _, from := range dependents[node] {
+			if remaining[from] {
+				outDegree[from]--
+			}
+		}
+	}
+
+	var head, tail []string
+	for len(remaining) > 0 {
+		progressed := false
+		for _, node := range nodes {
+			if remaining[node] && outDegree[node] == 0 {
+				tail = append(tail, node)
+				remove(node)
+				progressed = true
+			}
+		}
+		for _, node := range nodes {
+			if remaining[node] && inDegree[node] == 0 {
+				head = append(head, node)
+				remove(node)
+				progressed = true
+			}
+		}
+		if progressed {
+			continue
+		}
+
+		best := ""
+		for _, node := range nodes {
+			if !remaining[node] {
+				continue
+			}
+			if best == "" || outDegree[node]-inDegree[node] > outDegree[best]-inDegree[best] {
+				best = node
+			}
+		}
+		head = append(head, best)
+		remove(best)
+	}
+
+	for i := len(tail) - 1; i >= 0; i-- {
+		head = append(head, tail[i])
+	}
+	return head
+}
+
+// reachableWithout reports whether target can be reached from source without the removed edges.
+func reachableWithout(sub map[string][]string, removed map[FileEdge]bool, source, target string) bool {
+	visited := map[string]bool{source: true}
+	stack := []string{source}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if node == target {
+			return true
+		}
+		for _, next := range sub[node] {
+			if visited[next] || removed[FileEdge{From: node, To: next}] {
+				continue
+			}
+			visited[next] = true
+			stack = append(stack, next)
+		}
+	}
+	return false
+}
+
+// countCyclesThrough counts the simple cycles that use edge, which are the simple paths from
+// its target back to its source.
+func countCyclesThrough(sub map[string][]string, edge FileEdge) int {
+	if edge.From == edge.To {
+		return 1
+	}
+
+	count := 0
+	steps := 0
+	onPath := map[string]bool{edge.To: true}
+	var walk func(node string) bool
+	walk = func(node string) bool {
+		for _, next := range sub[node] {
+			steps++
+			if count >= cycleCountLimit || steps > cycleCountSteps {
+				return false
+			}
+			if next == edge.From {
+				count++
+				continue
+			}
+			if onPath[next] {
+				continue
+			}
+			onPath[next] = true
+			keepGoing := walk(next)
+			onPath[next] = false
+			if !keepGoing {
+				return false
+			}
+		}
+		return true
+	}
+	walk(edge.To)
+
+	return count
+}
+
+func sortCycleBreaks(breaks []CycleBreak) {
+	sort.Slice(breaks, func(i, j int) bool {
+		if breaks[i].Cycles != breaks[j].Cycles {
+			return breaks[i].Cycles > breaks[j].Cycles
+		}
+		if breaks[i].Edge.From != breaks[j].Edge.From {
+			return breaks[i].Edge.From < breaks[j].Edge.From
+		}
+		return breaks[i].Edge.To < breaks[j].Edge.To
+	})
+}
@@ -0,0 +1,126 @@
+package depgraph_test
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedbackArcSet_AcyclicGraph(t *testing.T) {
+	breaks := depgraph.FeedbackArcSet(map[string][]string{
+		"a.go": {"b.go"},
+		"b.go": {"c.go"},
+		"c.go": {},
+	})
+
+	assert.Empty(t, breaks)
+}
+
+func TestFeedbackArcSet_BreaksSimpleCycle(t *testing.T) {
+	breaks := depgraph.FeedbackArcSet(map[string][]string{
+		"a.go": {"b.go"},
+		"b.go": {"c.go"},
+		"c.go": {"a.go"},
+	})
+
+	require.Len(t, breaks, 1)
+	assert.Equal(t, 1, breaks[0].Cycles)
+}
+
+func TestFeedbackArcSet_PrefersEdgeSharedByCycles(t *testing.T) {
+	// Three cycles all run through hub.go -> core.go.
+	breaks := depgraph.FeedbackArcSet(map[string][]string{
+		"hub.go":  {"core.go"},
+		"core.go": {"a.go", "b.go", "c.go"},
+		"a.go":    {"hub.go"},
+		"b.go":    {"hub.go"},
+		"c.go":    {"hub.go"},
+	})
+
+	assert.Equal(t, []depgraph.CycleBreak{
+		{Edge: depgraph.FileEdge{From: "hub.go", To: "core.go"}, Cycles: 3},
+	}, breaks)
+}
+
+func TestFeedbackArcSet_IncludesSelfLoops(t *testing.T) {
+	breaks := depgraph.FeedbackArcSet(map[string][]string{
+		"a.go": {"a.go", "b.go"},
+		"b.go": {},
+	})
+
+	assert.Equal(t, []depgraph.CycleBreak{
+		{Edge: depgraph.FileEdge{From: "a.go", To: "a.go"}, Cycles: 1},
+	}, breaks)
+}
+
+func TestFeedbackArcSet_RemovalMakesGraphAcyclic(t *testing.T) {
+	adjacency := map[string][]string{
+		"a.go": {"b.go", "d.go"},
+		"b.go": {"c.go", "a.go"},
+		"c.go": {"a.go", "d.go"},
+		"d.go": {"b.go", "e.go"},
+		"e.go": {"c.go"},
+	}
+
+	breaks := depgraph.FeedbackArcSet(adjacency)
+	require.NotEmpty(t, breaks)
+
+	removed := make(map[depgraph.FileEdge]bool, len(breaks))
+	for _, cycleBreak := range breaks {
+		removed[cycleBreak.Edge] = true
+	}
+	remaining := make(map[string][]string, len(adjacency))
+	for from, deps := range adjacency {
+		remaining[from] = []string{}
+		for _, to := range deps {
+			if !removed[depgraph.FileEdge{From: from, To: to}] {
+				remaining[from] = append(remaining[from], to)
+			}
+		}
+	}
+	assert.Empty(t, depgraph.FeedbackArcSet(remaining))
+
+	// Restoring any single suggested edge brings a cycle back, so the set is minimal.
+	for _, cycleBreak := range breaks {
+		restored := make(map[string][]string, len(remaining))
+		for from, deps := range remaining {
+			restored[from] = append([]string(nil), deps...)
+		}
+		restored[cycleBreak.Edge.From] = append(restored[cycleBreak.Edge.From], cycleBreak.Edge.To)
+		assert.NotEmpty(t, depgraph.FeedbackArcSet(restored), "restoring %v", cycleBreak.Edge)
+	}
+
+	for i := 1; i < len(breaks); i++ {
+		assert.GreaterOrEqual(t, breaks[i-1].Cycles, breaks[i].Cycles)
+	}
+}
+
+func TestAttachCycleBreaks(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {"/project/a.go", "/project/c.go"},
+		"/project/c.go": {},
+	})
+
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, fileGraph.Meta.Cycles[0].Breaks)
+	require.NoError(t, depgraph.AttachCycleBreaks(fileGraph))
+
+	require.Len(t, fileGraph.Meta.Cycles, 1)
+	cycle := fileGraph.Meta.Cycles[0]
+	assert.Equal(t, []string{"/project/a.go", "/project/b.go"}, cycle.Nodes)
+	require.Len(t, cycle.Breaks, 1)
+
+	breakCount := 0
+	for edge, md := range fileGraph.Meta.Edges {
+		if md.BreaksCycle {
+			breakCount++
+			assert.Equal(t, cycle.Breaks[0].Edge, edge)
+			assert.True(t, md.InCycle)
+		}
+	}
+	assert.Equal(t, 1, breakCount)
+}
@@ -0,0 +1,145 @@
+package depgraph
+
+import (
+	"sort"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// GroupFileDependencyGraph collapses files into aggregate nodes keyed by groupOf.
+// Edges between files of different groups become a single group edge whose weight is the
+// number of underlying file edges; edges within a group are dropped. Files for which groupOf
+// returns an empty key keep their own path as the group key.
+func GroupFileDependencyGraph(g FileDependencyGraph, groupOf func(filePath string) string) (FileDependencyGraph, error) {
+	adjacency, err := AdjacencyList(g.Graph)
+	if err != nil {
+		return FileDependencyGraph{}, err
+	}
+
+	files := make([]string, 0, len(adjacency))
+	for file := range adjacency {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	groupKeys := make(map[string]string, len(files))
+	members := make(map[string][]string)
+	for _, file := range files {
+		key := groupOf(file)
+		if key == "" {
+			key = file
+		}
+		groupKeys[file] = key
+		members[key] = append(members[key], file)
+	}
+
+	weights := make(map[FileEdge]int)
+	imports := make(map[FileEdge][]ImportLocation)
+	relations := make(map[FileEdge]string)
+	groupAdjacency := make(map[string][]string, len(members))
+	for key := range members {
+		groupAdjacency[key] = nil
+	}
+	for _, file := range files {
+		from := groupKeys[file]
+		for _, dep := range adjacency[file] {
+			to, ok := groupKeys[dep]
+			if !ok || to == from {
+				continue
+			}
+			edge := FileEdge{From: from, To: to}
+			fileEdge := g.Meta.Edges[FileEdge{From: file, To: dep}]
+			if weights[edge] == 0 {
+				groupAdjacency[from] = append(groupAdjacency[from], to)
+				relations[edge] = fileEdge.Relation
+			} else if relations[edge] != fileEdge.Relation {
+				// A group edge keeps a relation only when every file edge behind it shares it.
+				relations[edge] = ""
+			}
+			weights[edge]++
+			imports[edge] = append(imports[edge], fileEdge.Imports...)
+		}
+	}
+
+	grouped, err := NewDependencyGraphFromAdjacency(groupAdjacency)
+	if err != nil {
+		return FileDependencyGraph{}, err
+	}
+
+	groupFiles := make(map[string]FileMetadata, len(members))
+	for key, groupMembers := range members {
+		groupFiles[key] = groupMetadata(groupMembers, g.Meta.Files)
+	}
+
+	edges := make(map[FileEdge]EdgeMetadata, len(weights))
+	for edge, weight := range weights {
+		sortImportLocations(imports[edge])
+		edges[edge] = EdgeMetadata{Weight: weight, Imports: imports[edge], Relation: relations[edge]}
+	}
+
+	cycles := markCycleEdges(groupAdjacency, edges)
+
+	return FileDependencyGraph{
+		Graph: grouped,
+		Meta: FileGraphMetadata{
+			Files:  groupFiles,
+			Edges:  edges,
+			Cycles: cycles,
+		},
+	}, nil
+}
+
+// groupMetadata summarizes member file metadata: stats are summed, a group is a test group
+// only when every member is a test file, and its extension is the most common member extension.
+func groupMetadata(members []string, files map[string]FileMetadata) FileMetadata {
+	var stats *vcs.FileStats
+	allTests := true
+	allNew := true
+	extensionCounts := make(map[string]int)
+
+	for _, member := range members {
+		md := files[member]
+		if !md.IsTest {
+			allTests = false
+		}
+		extensionCounts[md.Extension]++
+
+		if md.Stats == nil {
+			allNew = false
+			continue
+		}
+		if stats == nil {
+			stats = &vcs.FileStats{}
+		}
+		stats.Additions += md.Stats.Additions
+		stats.Deletions += md.Stats.Deletions
+		if !md.Stats.IsNew {
+			allNew = false
+		}
+	}
+	if stats != nil {
+		stats.IsNew = allNew
+	}
+
+	extensions := make([]string, 0, len(extensionCounts))
+	for ext := range extensionCounts {
+		extensions = append(extensions, ext)
+	}
+	sort.Strings(extensions)
+
+	majorityExtension := ""
+	maxCount := 0
+	for _, ext := range extensions {
+		if extensionCounts[ext] > maxCount {
+			maxCount = extensionCounts[ext]
+			majorityExtension = ext
+		}
+	}
+
+	return FileMetadata{
+		Stats:     stats,
+		IsTest:    allTests,
+		Extension: majorityExtension,
+		Members:   append([]string(nil), members...),
+	}
+}
@@ -0,0 +1,111 @@
+package depgraph_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupFileDependencyGraph_WeightsEdgesByFileEdgeCount(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/api/handler.go":     {"/project/store/db.go", "/project/store/cache.go", "/project/api/routes.go"},
+		"/project/api/routes.go":      {"/project/store/db.go"},
+		"/project/store/db.go":        {},
+		"/project/store/cache.go":     {"/project/store/db.go"},
+		"/project/store/db_test.go":   {"/project/store/db.go"},
+		"/project/cmd/main.go":        {"/project/api/handler.go"},
+		"/project/cmd/main_test.go":   {"/project/cmd/main.go"},
+		"/project/tools/unrelated.go": {},
+	})
+
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, nil, nil)
+	require.NoError(t, err)
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, filepath.Dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"/project/api":   {"/project/store"},
+		"/project/cmd":   {"/project/api"},
+		"/project/store": {},
+		"/project/tools": {},
+	}, mustAdjacency(t, grouped.Graph))
+
+	assert.Equal(t, 3, grouped.Meta.Edges[depgraph.FileEdge{From: "/project/api", To: "/project/store"}].Weight)
+	assert.Equal(t, 1, grouped.Meta.Edges[depgraph.FileEdge{From: "/project/cmd", To: "/project/api"}].Weight)
+	assert.Equal(t, []string{"/project/store/cache.go", "/project/store/db.go", "/project/store/db_test.go"}, grouped.Meta.Files["/project/store"].Members)
+	assert.Equal(t, ".go", grouped.Meta.Files["/project/store"].Extension)
+	assert.False(t, grouped.Meta.Files["/project/store"].IsTest)
+	assert.Empty(t, grouped.Meta.Cycles)
+}
+
+func TestGroupFileDependencyGraph_DetectsCyclesBetweenGroups(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/a/one.go":   {"/project/b/two.go"},
+		"/project/b/two.go":   {"/project/a/three.go"},
+		"/project/a/three.go": {},
+	})
+
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, fileGraph.Meta.Cycles)
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, filepath.Dir)
+	require.NoError(t, err)
+
+	require.Len(t, grouped.Meta.Cycles, 1)
+	assert.Equal(t, []string{"/project/a", "/project/b"}, grouped.Meta.Cycles[0].Path)
+	assert.True(t, grouped.Meta.Edges[depgraph.FileEdge{From: "/project/a", To: "/project/b"}].InCycle)
+	assert.True(t, grouped.Meta.Edges[depgraph.FileEdge{From: "/project/b", To: "/project/a"}].InCycle)
+}
+
+func TestGroupFileDependencyGraph_SumsStatsAndMarksAllTestGroups(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/lib/a.go":       {},
+		"/project/lib/b.go":       {},
+		"/project/test/a_test.go": {"/project/lib/a.go"},
+	})
+	stats := map[string]vcs.FileStats{
+		"/project/lib/a.go":       {Additions: 4, Deletions: 1, IsNew: true},
+		"/project/lib/b.go":       {Additions: 2},
+		"/project/test/a_test.go": {Additions: 7, IsNew: true},
+	}
+
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, stats, nil)
+	require.NoError(t, err)
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, filepath.Dir)
+	require.NoError(t, err)
+
+	lib := grouped.Meta.Files["/project/lib"]
+	require.NotNil(t, lib.Stats)
+	assert.Equal(t, vcs.FileStats{Additions: 6, Deletions: 1, IsNew: false}, *lib.Stats)
+	assert.False(t, lib.IsTest)
+
+	tests := grouped.Meta.Files["/project/test"]
+	require.NotNil(t, tests.Stats)
+	assert.Equal(t, vcs.FileStats{Additions: 7, IsNew: true}, *tests.Stats)
+	assert.True(t, tests.IsTest)
+}
+
+func TestGroupFileDependencyGraph_EmptyKeyKeepsFileNode(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {},
+	})
+
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, nil, nil)
+	require.NoError(t, err)
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, func(string) string { return "" })
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {},
+	}, mustAdjacency(t, grouped.Graph))
+}
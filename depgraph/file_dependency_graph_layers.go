@@ -0,0 +1,74 @@
+package depgraph
+
+import "sort"
+
+// DependencyLayer holds strongly connected components whose dependencies all lie in
+// earlier layers or inside the component itself.
+type DependencyLayer struct {
+	// Components lists each component's sorted members, ordered by their first member.
+	Components [][]string
+}
+
+// Nodes returns the layer's nodes with members of the same component kept together.
+func (l DependencyLayer) Nodes() []string {
+	var nodes []string
+	for _, component := range l.Components {
+		nodes = append(nodes, component...)
+	}
+	return nodes
+}
+
+// DependencyLayers orders the graph bottom-up: layer 0 holds components with no outside
+// dependencies, and every other component sits one layer above its highest dependency.
+// Cycles are collapsed into a single component, so the layering is defined for any graph.
+func DependencyLayers(adjacency map[string][]string) []DependencyLayer {
+	sccs := stronglyConnectedComponents(adjacency)
+
+	componentOf := make(map[string]int, len(adjacency))
+	for i, scc := range sccs {
+		for _, node := range scc {
+			componentOf[node] = i
+		}
+	}
+
+	layerOf := make(map[int]int, len(sccs))
+	var componentLayer func(component int) int
+	componentLayer = func(component int) int {
+		if layer, ok := layerOf[component]; ok {
+			return layer
+		}
+		layer := 0
+		for _, node := range sccs[component] {
+			for _, dep := range adjacency[node] {
+				depComponent, ok := componentOf[dep]
+				if !ok || depComponent == component {
+					continue
+				}
+				if depLayer := componentLayer(depComponent) + 1; depLayer > layer {
+					layer = depLayer
+				}
+			}
+		}
+		layerOf[component] = layer
+		return layer
+	}
+
+	maxLayer := -1
+	for i := range sccs {
+		if layer := componentLayer(i); layer > maxLayer {
+			maxLayer = layer
+		}
+	}
+
+	layers := make([]DependencyLayer, maxLayer+1)
+	for i, scc := range sccs {
+		layer := layerOf[i]
+		layers[layer].Components = append(layers[layer].Components, scc)
+	}
+	for _, layer := range layers {
+		sort.Slice(layer.Components, func(i, j int) bool {
+			return layer.Components[i][0] < layer.Components[j][0]
+		})
+	}
+	return layers
+}
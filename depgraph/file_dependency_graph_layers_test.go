@@ -0,0 +1,46 @@
+package depgraph_test
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyLayers_OrdersDependenciesBeforeDependents(t *testing.T) {
+	layers := depgraph.DependencyLayers(map[string][]string{
+		"main.go":      {"server.go", "config.go"},
+		"server.go":    {"store.go"},
+		"store.go":     {},
+		"config.go":    {},
+		"main_test.go": {"main.go"},
+	})
+
+	assert.Equal(t, []depgraph.DependencyLayer{
+		{Components: [][]string{{"config.go"}, {"store.go"}}},
+		{Components: [][]string{{"server.go"}}},
+		{Components: [][]string{{"main.go"}}},
+		{Components: [][]string{{"main_test.go"}}},
+	}, layers)
+}
+
+func TestDependencyLayers_CollapsesCyclesIntoOneComponent(t *testing.T) {
+	layers := depgraph.DependencyLayers(map[string][]string{
+		"a.go":    {"b.go"},
+		"b.go":    {"c.go", "util.go"},
+		"c.go":    {"a.go"},
+		"util.go": {},
+		"cmd.go":  {"a.go"},
+	})
+
+	assert.Equal(t, []depgraph.DependencyLayer{
+		{Components: [][]string{{"util.go"}}},
+		{Components: [][]string{{"a.go", "b.go", "c.go"}}},
+		{Components: [][]string{{"cmd.go"}}},
+	}, layers)
+	assert.Equal(t, []string{"a.go", "b.go", "c.go"}, layers[1].Nodes())
+}
+
+func TestDependencyLayers_EmptyGraph(t *testing.T) {
+	assert.Empty(t, depgraph.DependencyLayers(map[string][]string{}))
+}
@@ -64,6 +64,18 @@ func TestNewFileDependencyGraph_DetectsCycles(t *testing.T) {
 	assert.False(t, fileGraph.Meta.Edges[depgraph.FileEdge{From: "/project/d.go", To: "/project/d.go"}].InCycle)
 }
 
+func TestFindCycles(t *testing.T) {
+	cycles := depgraph.FindCycles(map[string][]string{
+		"/project/a.go": {"/project/b.go"},
+		"/project/b.go": {"/project/a.go"},
+		"/project/c.go": {"/project/a.go"},
+	})
+
+	require.Len(t, cycles, 1)
+	assert.Equal(t, []string{"/project/a.go", "/project/b.go"}, cycles[0].Nodes)
+	assert.Empty(t, cycles[0].Breaks)
+}
+
 func TestNewFileDependencyGraph_MarksAllEdgesInCyclicSCC(t *testing.T) {
 	graph := depgraph.MustDependencyGraph(map[string][]string{
 		"/project/a.go": {"/project/b.go", "/project/c.go"},
@@ -1,5 +1,7 @@
 package depgraph
 
+import "sort"
+
 // FindPathNodes returns all nodes on any path between specified files.
 // Treats the graph bidirectionally (paths from A to B OR from B to A).
 // A node X is included if it lies on any directed path between any pair of target files.
@@ -150,3 +152,50 @@ func extractSubgraph(original map[string][]string, nodesToKeep map[string]bool)
 
 	return MustDependencyGraph(result)
 }
+
+// FindSimplePaths returns directed paths from source to target that visit no node twice,
+// in lexical order of their node sequence. At most limit paths are returned when limit > 0;
+// truncated reports whether more paths exist.
+func FindSimplePaths(adjacency map[string][]string, source, target string, limit int) (paths [][]string, truncated bool) {
+	if _, ok := adjacency[source]; !ok {
+		return nil, false
+	}
+
+	path := []string{source}
+	onPath := map[string]bool{source: true}
+
+	var walk func(current string) bool
+	walk = func(current string) bool {
+		neighbors := append([]string(nil), adjacency[current]...)
+		sort.Strings(neighbors)
+
+		for _, next := range neighbors {
+			if onPath[next] {
+				continue
+			}
+			if next == target {
+				if limit > 0 && len(paths) == limit {
+					truncated = true
+					return false
+				}
+				paths = append(paths, append(append([]string(nil), path...), target))
+				continue
+			}
+
+			path = append(path, next)
+			onPath[next] = true
+			keepGoing := walk(next)
+			onPath[next] = false
+			path = path[:len(path)-1]
+			if !keepGoing {
+				return false
+			}
+		}
+		return true
+	}
+
+	if source != target {
+		walk(source)
+	}
+	return paths, truncated
+}
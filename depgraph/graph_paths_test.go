@@ -1,6 +1,7 @@
 package depgraph
 
 import (
+	"reflect"
 	"sort"
 	"testing"
 )
@@ -229,3 +230,48 @@ func assertGraphContainsNodes(t *testing.T, graph DependencyGraph, expectedNodes
 		t.Errorf("Expected %d nodes %v, got %d nodes %v", len(expectedNodes), expectedNodes, len(actualNodes), actualNodes)
 	}
 }
+
+func TestFindSimplePaths_ListsPathsInOrder(t *testing.T) {
+	adjacency := map[string][]string{
+		"A": {"C", "B"},
+		"B": {"D"},
+		"C": {"D", "A"},
+		"D": {},
+	}
+
+	paths, truncated := FindSimplePaths(adjacency, "A", "D", 0)
+
+	if truncated {
+		t.Fatalf("expected all paths to be returned")
+	}
+	want := [][]string{{"A", "B", "D"}, {"A", "C", "D"}}
+	if !reflect.DeepEqual(paths, want) {
+		t.Fatalf("FindSimplePaths() = %v, want %v", paths, want)
+	}
+}
+
+func TestFindSimplePaths_RespectsLimit(t *testing.T) {
+	adjacency := map[string][]string{
+		"A": {"B", "C"},
+		"B": {"D"},
+		"C": {"D"},
+		"D": {},
+	}
+
+	paths, truncated := FindSimplePaths(adjacency, "A", "D", 1)
+
+	if !truncated {
+		t.Fatalf("expected paths to be truncated")
+	}
+	if !reflect.DeepEqual(paths, [][]string{{"A", "B", "D"}}) {
+		t.Fatalf("FindSimplePaths() = %v, want first path only", paths)
+	}
+}
+
+func TestFindSimplePaths_NoPath(t *testing.T) {
+	paths, truncated := FindSimplePaths(map[string][]string{"A": {}, "B": {"A"}}, "A", "B", 0)
+
+	if len(paths) != 0 || truncated {
+		t.Fatalf("FindSimplePaths() = %v, %v, want no paths", paths, truncated)
+	}
+}
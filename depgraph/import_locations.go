@@ -0,0 +1,65 @@
+package depgraph
+
+import (
+	"sort"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/registry"
+)
+
+// ImportLocation is an import statement that creates a dependency edge.
+type ImportLocation struct {
+	// File is the absolute path of the importing file.
+	File string
+	// Line and Column are 1-based and point at the start of the import statement or require() call.
+	Line   int
+	Column int
+	// Specifier is the import path or module name as written in the source.
+	Specifier string
+}
+
+// ImportIndex maps dependency edges to the import statements behind them.
+type ImportIndex map[FileEdge][]ImportLocation
+
+func (idx ImportIndex) add(edge FileEdge, location ImportLocation) {
+	for _, existing := range idx[edge] {
+		if existing == location {
+			return
+		}
+	}
+	idx[edge] = append(idx[edge], location)
+}
+
+// LocatesImports reports whether import statements are located for files with the extension.
+// Edges from other files never carry ImportLocation metadata.
+func LocatesImports(ext string) bool {
+	return registry.LocatesImports(ext)
+}
+
+// AttachImportLocations records the import statements behind each edge of g in its edge metadata.
+// Edges missing from g are ignored, so the index may come from an unfiltered graph.
+func AttachImportLocations(g FileDependencyGraph, imports ImportIndex) {
+	for edge, locations := range imports {
+		md, ok := g.Meta.Edges[edge]
+		if !ok {
+			continue
+		}
+		md.Imports = append([]ImportLocation(nil), locations...)
+		sortImportLocations(md.Imports)
+		g.Meta.Edges[edge] = md
+	}
+}
+
+func sortImportLocations(locations []ImportLocation) {
+	sort.Slice(locations, func(i, j int) bool {
+		if locations[i].File != locations[j].File {
+			return locations[i].File < locations[j].File
+		}
+		if locations[i].Line != locations[j].Line {
+			return locations[i].Line < locations[j].Line
+		}
+		if locations[i].Column != locations[j].Column {
+			return locations[i].Column < locations[j].Column
+		}
+		return locations[i].Specifier < locations[j].Specifier
+	})
+}
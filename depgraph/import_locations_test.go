@@ -0,0 +1,69 @@
+package depgraph_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDependencyGraphWithOptions_LocatesEdgeImports(t *testing.T) {
+	tmpDir := t.TempDir()
+	appPath := filepath.Join(tmpDir, "app.ts")
+	userPath := filepath.Join(tmpDir, "user.ts")
+	utilPath := filepath.Join(tmpDir, "util.ts")
+	require.NoError(t, os.WriteFile(appPath, []byte("import React from 'react'\nimport { User } from './user'\nexport { format } from './util'\n"), 0644))
+	require.NoError(t, os.WriteFile(userPath, []byte("export class User {}\n"), 0644))
+	require.NoError(t, os.WriteFile(utilPath, []byte("export const format = 1\n"), 0644))
+
+	built, err := depgraph.BuildDependencyGraphWithOptions([]string{appPath, userPath, utilPath}, vcs.FilesystemContentReader(), depgraph.BuildOptions{LocateImports: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{userPath, utilPath}, mustAdjacency(t, built.Graph)[appPath])
+	assert.Equal(t, depgraph.ImportIndex{
+		{From: appPath, To: userPath}: {{File: appPath, Line: 2, Column: 1, Specifier: "./user"}},
+		{From: appPath, To: utilPath}: {{File: appPath, Line: 3, Column: 1, Specifier: "./util"}},
+	}, built.Imports)
+}
+
+func TestAttachImportLocations_MergesLocationsIntoGroupEdges(t *testing.T) {
+	graph := depgraph.MustDependencyGraph(map[string][]string{
+		"/project/api/handler.go": {"/project/store/db.go"},
+		"/project/api/routes.go":  {"/project/store/db.go"},
+		"/project/store/db.go":    {},
+	})
+	fileGraph, err := depgraph.NewFileDependencyGraph(graph, nil, nil)
+	require.NoError(t, err)
+
+	depgraph.AttachImportLocations(fileGraph, depgraph.ImportIndex{
+		{From: "/project/api/routes.go", To: "/project/store/db.go"}:  {{File: "/project/api/routes.go", Line: 4, Column: 2, Specifier: "example.com/project/store"}},
+		{From: "/project/api/handler.go", To: "/project/store/db.go"}: {{File: "/project/api/handler.go", Line: 5, Column: 2, Specifier: "example.com/project/store"}},
+		{From: "/project/api/missing.go", To: "/project/store/db.go"}: {{File: "/project/api/missing.go", Line: 1, Column: 1, Specifier: "example.com/project/store"}},
+	})
+	assert.Len(t, fileGraph.Meta.Edges[depgraph.FileEdge{From: "/project/api/routes.go", To: "/project/store/db.go"}].Imports, 1)
+
+	grouped, err := depgraph.GroupFileDependencyGraph(fileGraph, filepath.Dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []depgraph.ImportLocation{
+		{File: "/project/api/handler.go", Line: 5, Column: 2, Specifier: "example.com/project/store"},
+		{File: "/project/api/routes.go", Line: 4, Column: 2, Specifier: "example.com/project/store"},
+	}, grouped.Meta.Edges[depgraph.FileEdge{From: "/project/api", To: "/project/store"}].Imports)
+}
+
+func TestBuildDependencyGraphWithOptions_SkipsImportLocationsByDefault(t *testing.T) {
+	tmpDir := t.TempDir()
+	appPath := filepath.Join(tmpDir, "app.ts")
+	utilPath := filepath.Join(tmpDir, "util.ts")
+	require.NoError(t, os.WriteFile(appPath, []byte("import { helper } from './util';\n"), 0644))
+	require.NoError(t, os.WriteFile(utilPath, []byte("export const helper = 1;\n"), 0644))
+
+	built, err := depgraph.BuildDependencyGraphWithOptions([]string{appPath, utilPath}, vcs.FilesystemContentReader(), depgraph.BuildOptions{})
+	require.NoError(t, err)
+
+	assert.Nil(t, built.Imports)
+}
@@ -0,0 +1,36 @@
+package astro
+
+import (
+	"fmt"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/sfc"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// astroComponentExtensions lists the component files an Astro page can import.
+// Astro islands are commonly written in other frameworks.
+var astroComponentExtensions = []string{".astro", ".vue", ".svelte"}
+
+func ResolveAstroProjectImports(
+	absPath string,
+	filePath string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	importPaths, parseErr := ParseAstroImports(content)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, parseErr)
+	}
+
+	var projectImports []string
+	for _, importPath := range importPaths {
+		projectImports = append(projectImports, sfc.ResolveComponentImportPath(absPath, importPath, astroComponentExtensions, suppliedFiles)...)
+	}
+
+	return projectImports, nil
+}
@@ -0,0 +1,31 @@
+package astro
+
+import (
+	"fmt"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/sfc"
+)
+
+// ParseAstroImports parses an Astro component and returns the project-internal import
+// paths from its frontmatter and <script> blocks. Astro compiles both as TypeScript.
+func ParseAstroImports(sourceCode []byte) ([]string, error) {
+	var blocks []sfc.ScriptBlock
+
+	frontmatter, body, ok := sfc.SplitFrontmatter(sourceCode)
+	if ok {
+		blocks = append(blocks, sfc.ScriptBlock{Content: frontmatter, Lang: "ts"})
+	}
+
+	scripts, err := sfc.ParseScriptBlocks(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Astro code: %w", err)
+	}
+	for _, script := range scripts {
+		if script.Lang == "" {
+			script.Lang = "ts"
+		}
+		blocks = append(blocks, script)
+	}
+
+	return sfc.InternalImportPaths(blocks), nil
+}
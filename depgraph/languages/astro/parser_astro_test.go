@@ -0,0 +1,42 @@
+package astro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAstroImports_FrontmatterAndScripts(t *testing.T) {
+	source := `---
+import Layout from '../layouts/Layout.astro'
+import Counter from '../components/Counter.vue'
+import type { Post } from '@/content/post'
+const { title } = Astro.props
+---
+<Layout title={title}>
+  <Counter client:load />
+</Layout>
+<script>
+  import { track } from '../lib/analytics'
+  track('view')
+</script>
+`
+
+	imports, err := ParseAstroImports([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"../layouts/Layout.astro",
+		"../components/Counter.vue",
+		"@/content/post",
+		"../lib/analytics",
+	}, imports)
+}
+
+func TestParseAstroImports_NoFrontmatter(t *testing.T) {
+	imports, err := ParseAstroImports([]byte("<h1>Hello</h1>\n"))
+
+	require.NoError(t, err)
+	assert.Empty(t, imports)
+}
@@ -0,0 +1,21 @@
+package astro
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsTestFile reports whether the given Astro file path is a test file.
+func IsTestFile(filePath string) bool {
+	fileName := filepath.Base(filePath)
+	ext := filepath.Ext(fileName)
+	if ext != ".astro" {
+		return false
+	}
+
+	if strings.HasSuffix(fileName, ".test"+ext) || strings.HasSuffix(fileName, ".spec"+ext) {
+		return true
+	}
+
+	return strings.Contains(filepath.ToSlash(filePath), "/__tests__/")
+}
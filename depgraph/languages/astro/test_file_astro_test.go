@@ -0,0 +1,12 @@
+package astro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("src/components/Card.test.astro"))
+	assert.False(t, IsTestFile("src/pages/index.astro"))
+}
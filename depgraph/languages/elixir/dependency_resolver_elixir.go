@@ -0,0 +1,76 @@
+package elixir
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// ResolveElixirProjectImports resolves module references to the supplied files that define them.
+// References to modules defined more than once are skipped as ambiguous.
+func ResolveElixirProjectImports(
+	absPath string,
+	filePath string,
+	moduleIndex map[string][]string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	source, err := ParseElixirSource(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, err)
+	}
+
+	seen := make(map[string]bool)
+	var projectImports []string
+	for _, ref := range source.References {
+		files := moduleIndex[ref.Module]
+		if len(files) != 1 {
+			continue
+		}
+		file := files[0]
+		if file == absPath || !suppliedFiles[file] || seen[file] {
+			continue
+		}
+		seen[file] = true
+		projectImports = append(projectImports, file)
+	}
+
+	return projectImports, nil
+}
+
+// BuildElixirModuleIndex maps module names to the files that define them with `defmodule`.
+func BuildElixirModuleIndex(elixirFiles []string, contentReader vcs.ContentReader) map[string][]string {
+	index := make(map[string][]string)
+	for _, path := range elixirFiles {
+		content, err := contentReader(path)
+		if err != nil {
+			continue
+		}
+		source, err := ParseElixirSource(content)
+		if err != nil {
+			continue
+		}
+		for _, module := range source.DefinedModules {
+			index[module] = append(index[module], path)
+		}
+	}
+	return index
+}
+
+func collectElixirFiles(suppliedFiles map[string]bool) []string {
+	var elixirFiles []string
+	for path, exists := range suppliedFiles {
+		if ext := filepath.Ext(path); exists && (ext == ".ex" || ext == ".exs") {
+			elixirFiles = append(elixirFiles, path)
+		}
+	}
+	sort.Strings(elixirFiles)
+	return elixirFiles
+}
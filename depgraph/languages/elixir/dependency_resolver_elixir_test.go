@@ -0,0 +1,59 @@
+package elixir_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_ElixirResolvesDirectivesThroughModuleIndex(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	userPath := filepath.Join(tmpDir, "lib", "my_app", "accounts", "user.ex")
+	repoPath := filepath.Join(tmpDir, "lib", "my_app", "repo.ex")
+	webPath := filepath.Join(tmpDir, "lib", "my_app_web.ex")
+	controllerPath := filepath.Join(tmpDir, "lib", "my_app_web", "controllers", "user_controller.ex")
+	testPath := filepath.Join(tmpDir, "test", "my_app_web", "controllers", "user_controller_test.exs")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(userPath), 0o755))
+	require.NoError(t, os.WriteFile(userPath, []byte("defmodule MyApp.Accounts.User do\nend\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(repoPath), 0o755))
+	require.NoError(t, os.WriteFile(repoPath, []byte("defmodule MyApp.Repo do\n  use Ecto.Repo, otp_app: :my_app\nend\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(webPath), 0o755))
+	require.NoError(t, os.WriteFile(webPath, []byte("defmodule MyAppWeb do\n  defmacro __using__(_), do: nil\nend\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(controllerPath), 0o755))
+	require.NoError(t, os.WriteFile(controllerPath, []byte(`defmodule MyAppWeb.UserController do
+  use MyAppWeb, :controller
+  alias MyApp.Accounts.User
+
+  def show(conn, %{"id" => id}), do: MyApp.Repo.get(User, id)
+end
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(testPath), 0o755))
+	require.NoError(t, os.WriteFile(testPath, []byte(`defmodule MyAppWeb.UserControllerTest do
+  use ExUnit.Case
+  alias MyAppWeb.UserController
+end
+`), 0o644))
+
+	files := []string{userPath, repoPath, webPath, controllerPath, testPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.ElementsMatch(t, []string{webPath, userPath, repoPath}, adj[controllerPath])
+	assert.Equal(t, []string{controllerPath}, adj[testPath])
+	assert.Empty(t, adj[repoPath])
+}
@@ -0,0 +1,46 @@
+package elixir
+
+import (
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+type Module struct{}
+
+func (Module) Name() string {
+	return "Elixir"
+}
+
+func (Module) Extensions() []string {
+	return []string{".ex", ".exs"}
+}
+
+func (Module) Maturity() moduleapi.MaturityLevel {
+	return moduleapi.MaturityUntested
+}
+
+func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
+	return resolver{
+		ctx:           ctx,
+		contentReader: contentReader,
+		moduleIndex:   BuildElixirModuleIndex(collectElixirFiles(ctx.SuppliedFiles), contentReader),
+	}
+}
+
+func (Module) IsTestFile(filePath string, _ vcs.ContentReader) bool {
+	return IsTestFile(filePath)
+}
+
+type resolver struct {
+	ctx           *moduleapi.Context
+	contentReader vcs.ContentReader
+	moduleIndex   map[string][]string
+}
+
+func (r resolver) ResolveProjectImports(absPath, filePath, _ string) ([]string, error) {
+	return ResolveElixirProjectImports(absPath, filePath, r.moduleIndex, r.ctx.SuppliedFiles, r.contentReader)
+}
+
+func (resolver) FinalizeGraph(_ moduleapi.Graph) error {
+	return nil
+}
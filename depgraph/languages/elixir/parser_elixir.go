@@ -0,0 +1,281 @@
+package elixir
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	tselixir "github.com/smacker/go-tree-sitter/elixir"
+)
+
+// ReferenceKind describes how an Elixir module is referenced.
+type ReferenceKind string
+
+const (
+	ReferenceAlias   ReferenceKind = "alias"
+	ReferenceImport  ReferenceKind = "import"
+	ReferenceUse     ReferenceKind = "use"
+	ReferenceRequire ReferenceKind = "require"
+	// ReferenceCall is a remote call such as `MyApp.Repo.all(query)`.
+	ReferenceCall ReferenceKind = "call"
+)
+
+// ModuleReference is a fully expanded reference to an Elixir module.
+type ModuleReference struct {
+	Module string
+	Kind   ReferenceKind
+}
+
+// ElixirSource holds the modules defined and referenced by an Elixir file.
+type ElixirSource struct {
+	DefinedModules []string
+	References     []ModuleReference
+}
+
+// ElixirImports parses an Elixir file and returns its module definitions and references.
+func ElixirImports(filePath string) (ElixirSource, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return ElixirSource{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return ParseElixirSource(sourceCode)
+}
+
+// ParseElixirSource parses Elixir source code. Nested `defmodule` names are expanded
+// against their parent, and aliases (including `as:` and `__MODULE__`) are expanded
+// in later references.
+func ParseElixirSource(sourceCode []byte) (ElixirSource, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(tselixir.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return ElixirSource{}, fmt.Errorf("failed to parse Elixir code: %w", err)
+	}
+	defer tree.Close()
+
+	extractor := &elixirExtractor{
+		sourceCode: sourceCode,
+		aliases:    make(map[string]string),
+		seen:       make(map[ModuleReference]bool),
+	}
+	extractor.walk(tree.RootNode(), "")
+
+	return extractor.result, nil
+}
+
+type elixirExtractor struct {
+	sourceCode []byte
+	result     ElixirSource
+	// aliases maps the short name introduced by `alias` to the full module name.
+	aliases map[string]string
+	seen    map[ModuleReference]bool
+}
+
+func (e *elixirExtractor) walk(node *sitter.Node, currentModule string) {
+	if node.Type() == "call" {
+		if handled := e.handleCall(node, currentModule); handled {
+			return
+		}
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		e.walk(node.NamedChild(i), currentModule)
+	}
+}
+
+// handleCall records definitions and references for a call node and reports whether
+// its children have already been walked.
+func (e *elixirExtractor) handleCall(node *sitter.Node, currentModule string) bool {
+	target := node.ChildByFieldName("target")
+	if target == nil {
+		return false
+	}
+
+	switch target.Type() {
+	case "identifier":
+		args := firstChildOfType(node, "arguments")
+		switch target.Content(e.sourceCode) {
+		case "defmodule":
+			if args == nil || args.NamedChildCount() == 0 {
+				return false
+			}
+			name := e.moduleName(args.NamedChild(0), currentModule)
+			if name == "" {
+				return false
+			}
+			moduleName := name
+			if currentModule != "" && args.NamedChild(0).Type() == "alias" {
+				name = args.NamedChild(0).Content(e.sourceCode)
+				moduleName = currentModule + "." + name
+				// A nested module is automatically aliased inside its parent.
+				e.aliases[firstSegment(name)] = currentModule + "." + firstSegment(name)
+			}
+			e.result.DefinedModules = append(e.result.DefinedModules, moduleName)
+			if block := firstChildOfType(node, "do_block"); block != nil {
+				e.walk(block, moduleName)
+			}
+			return true
+		case "alias":
+			e.handleAlias(args, currentModule)
+			return true
+		case "import":
+			e.handleDirective(args, currentModule, ReferenceImport)
+			return true
+		case "use":
+			e.handleDirective(args, currentModule, ReferenceUse)
+			return true
+		case "require":
+			e.handleDirective(args, currentModule, ReferenceRequire)
+			return true
+		}
+	case "dot":
+		if left := target.ChildByFieldName("left"); left != nil && left.Type() == "alias" {
+			e.addReference(e.expand(left.Content(e.sourceCode)), ReferenceCall)
+		}
+	}
+
+	return false
+}
+
+func (e *elixirExtractor) handleAlias(args *sitter.Node, currentModule string) {
+	if args == nil || args.NamedChildCount() == 0 {
+		return
+	}
+
+	first := args.NamedChild(0)
+	if first.Type() == "dot" {
+		if right := first.ChildByFieldName("right"); right != nil && right.Type() == "tuple" {
+			prefix := e.moduleName(first.ChildByFieldName("left"), currentModule)
+			for i := 0; i < int(right.NamedChildCount()); i++ {
+				child := right.NamedChild(i)
+				if child.Type() != "alias" || prefix == "" {
+					continue
+				}
+				name := child.Content(e.sourceCode)
+				full := prefix + "." + name
+				e.aliases[lastSegment(name)] = full
+				e.addReference(full, ReferenceAlias)
+			}
+			return
+		}
+	}
+
+	full := e.moduleName(first, currentModule)
+	if full == "" {
+		return
+	}
+
+	aliasName := lastSegment(full)
+	if as := keywordValue(args, "as", e.sourceCode); as != "" {
+		aliasName = as
+	}
+	e.aliases[aliasName] = full
+	e.addReference(full, ReferenceAlias)
+}
+
+func (e *elixirExtractor) handleDirective(args *sitter.Node, currentModule string, kind ReferenceKind) {
+	if args == nil || args.NamedChildCount() == 0 {
+		return
+	}
+	if name := e.moduleName(args.NamedChild(0), currentModule); name != "" {
+		e.addReference(name, kind)
+	}
+}
+
+// moduleName expands an alias or `__MODULE__.Name` node to a full module name.
+func (e *elixirExtractor) moduleName(node *sitter.Node, currentModule string) string {
+	if node == nil {
+		return ""
+	}
+
+	switch node.Type() {
+	case "alias":
+		return e.expand(node.Content(e.sourceCode))
+	case "identifier":
+		if node.Content(e.sourceCode) == "__MODULE__" {
+			return currentModule
+		}
+	case "dot":
+		left := node.ChildByFieldName("left")
+		right := node.ChildByFieldName("right")
+		if left == nil || right == nil || right.Type() != "alias" {
+			return ""
+		}
+		prefix := e.moduleName(left, currentModule)
+		if prefix == "" {
+			return ""
+		}
+		return prefix + "." + right.Content(e.sourceCode)
+	}
+
+	return ""
+}
+
+// expand replaces the first segment of a module name when it matches an alias.
+func (e *elixirExtractor) expand(name string) string {
+	first, rest, hasRest := strings.Cut(name, ".")
+	full, ok := e.aliases[first]
+	if !ok {
+		return name
+	}
+	if hasRest {
+		return full + "." + rest
+	}
+	return full
+}
+
+func (e *elixirExtractor) addReference(module string, kind ReferenceKind) {
+	ref := ModuleReference{Module: module, Kind: kind}
+	if module == "" || e.seen[ref] {
+		return
+	}
+	e.seen[ref] = true
+	e.result.References = append(e.result.References, ref)
+}
+
+func keywordValue(args *sitter.Node, key string, sourceCode []byte) string {
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		keywords := args.NamedChild(i)
+		if keywords.Type() != "keywords" {
+			continue
+		}
+		for j := 0; j < int(keywords.NamedChildCount()); j++ {
+			pair := keywords.NamedChild(j)
+			keyNode := pair.ChildByFieldName("key")
+			value := pair.ChildByFieldName("value")
+			if keyNode == nil || value == nil {
+				continue
+			}
+			keyName := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(keyNode.Content(sourceCode)), ":"))
+			if keyName == key && value.Type() == "alias" {
+				return value.Content(sourceCode)
+			}
+		}
+	}
+	return ""
+}
+
+func firstChildOfType(node *sitter.Node, nodeType string) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == nodeType {
+			return child
+		}
+	}
+	return nil
+}
+
+func firstSegment(name string) string {
+	first, _, _ := strings.Cut(name, ".")
+	return first
+}
+
+func lastSegment(name string) string {
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
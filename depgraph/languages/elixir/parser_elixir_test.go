@@ -0,0 +1,57 @@
+package elixir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseElixirSource(t *testing.T) {
+	source := `defmodule MyApp.Accounts do
+  alias MyApp.Accounts.{User, Team}
+  alias MyApp.Repo, as: R
+  alias __MODULE__.Policy
+  import Ecto.Query, only: [from: 2]
+  use MyAppWeb, :controller
+  require Logger
+
+  defmodule Cache do
+  end
+
+  def get(id), do: User.get(id) |> R.preload() |> MyApp.Mailer.deliver()
+  def cached, do: Cache.fetch()
+end
+`
+
+	parsed, err := ParseElixirSource([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MyApp.Accounts", "MyApp.Accounts.Cache"}, parsed.DefinedModules)
+	assert.Equal(t, []ModuleReference{
+		{Module: "MyApp.Accounts.User", Kind: ReferenceAlias},
+		{Module: "MyApp.Accounts.Team", Kind: ReferenceAlias},
+		{Module: "MyApp.Repo", Kind: ReferenceAlias},
+		{Module: "MyApp.Accounts.Policy", Kind: ReferenceAlias},
+		{Module: "Ecto.Query", Kind: ReferenceImport},
+		{Module: "MyAppWeb", Kind: ReferenceUse},
+		{Module: "Logger", Kind: ReferenceRequire},
+		{Module: "MyApp.Accounts.User", Kind: ReferenceCall},
+		{Module: "MyApp.Repo", Kind: ReferenceCall},
+		{Module: "MyApp.Mailer", Kind: ReferenceCall},
+		{Module: "MyApp.Accounts.Cache", Kind: ReferenceCall},
+	}, parsed.References)
+}
+
+func TestElixirImports_ValidFile(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "app.ex")
+	require.NoError(t, os.WriteFile(tmpFile, []byte("defmodule App do\n  use GenServer\nend\n"), 0o644))
+
+	parsed, err := ElixirImports(tmpFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"App"}, parsed.DefinedModules)
+	assert.Equal(t, []ModuleReference{{Module: "GenServer", Kind: ReferenceUse}}, parsed.References)
+}
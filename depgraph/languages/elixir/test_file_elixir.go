@@ -0,0 +1,24 @@
+package elixir
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsTestFile reports whether the given Elixir path belongs to the ExUnit test suite.
+// Besides `*_test.exs` scripts, everything under a test/ directory counts, so test_helper.exs
+// and the case templates in test/support/ are grouped with the tests that load them.
+func IsTestFile(filePath string) bool {
+	fileName := filepath.Base(filePath)
+	ext := filepath.Ext(fileName)
+	if ext != ".ex" && ext != ".exs" {
+		return false
+	}
+
+	if ext == ".exs" && strings.HasSuffix(strings.TrimSuffix(fileName, ext), "_test") {
+		return true
+	}
+
+	slashed := "/" + filepath.ToSlash(filePath)
+	return strings.Contains(slashed, "/test/")
+}
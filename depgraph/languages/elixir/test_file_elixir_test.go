@@ -0,0 +1,18 @@
+package elixir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("test/my_app/accounts_test.exs"))
+	assert.True(t, IsTestFile("test/test_helper.exs"))
+	assert.True(t, IsTestFile("test/support/conn_case.ex"))
+	assert.True(t, IsTestFile("apps/my_app/test/support/data_case.ex"))
+	assert.False(t, IsTestFile("lib/my_app/accounts.ex"))
+	assert.False(t, IsTestFile("lib/my_app/accounts_test.ex"))
+	assert.False(t, IsTestFile("lib/my_app/latest/report.ex"))
+	assert.False(t, IsTestFile("test/fixtures/data.json"))
+}
@@ -0,0 +1,120 @@
+package erlang
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// ResolveErlangProjectImports resolves includes and module references for an Erlang file.
+func ResolveErlangProjectImports(
+	absPath string,
+	_ string,
+	moduleIndex map[string][]string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	source := ParseErlangSource(content)
+
+	seen := make(map[string]bool)
+	var projectImports []string
+	add := func(path string) {
+		if path == "" || path == absPath || !suppliedFiles[path] || seen[path] {
+			return
+		}
+		seen[path] = true
+		projectImports = append(projectImports, path)
+	}
+
+	for _, inc := range source.Includes {
+		add(ResolveErlangIncludePath(absPath, inc, suppliedFiles))
+	}
+	for _, module := range source.ModuleReferences {
+		if files := moduleIndex[module]; len(files) == 1 {
+			add(files[0])
+		}
+	}
+
+	return projectImports, nil
+}
+
+// ResolveErlangIncludePath resolves an include attribute to a supplied file.
+//
+// -include paths are tried relative to the including file and to the sibling include/
+// directory of a rebar3 application. -include_lib paths start with an application name,
+// which is matched against the supplied files' directories. Ambiguous matches are skipped.
+func ResolveErlangIncludePath(sourceFile string, inc ErlangInclude, suppliedFiles map[string]bool) string {
+	includePath := filepath.FromSlash(inc.Path)
+	sourceDir := filepath.Dir(sourceFile)
+
+	if inc.Kind == IncludeLocal {
+		for _, base := range []string{sourceDir, filepath.Join(filepath.Dir(sourceDir), "include")} {
+			candidate := filepath.Clean(filepath.Join(base, includePath))
+			if suppliedFiles[candidate] {
+				return candidate
+			}
+		}
+		return ""
+	}
+
+	if match := uniqueSuffixMatch("/"+filepath.ToSlash(inc.Path), suppliedFiles); match != "" {
+		return match
+	}
+
+	// Application directories often carry a version suffix (app-1.2.0), so fall back to
+	// matching the path below the application name.
+	if _, rest, ok := strings.Cut(filepath.ToSlash(inc.Path), "/"); ok {
+		return uniqueSuffixMatch("/"+rest, suppliedFiles)
+	}
+
+	return ""
+}
+
+func uniqueSuffixMatch(suffix string, suppliedFiles map[string]bool) string {
+	match := ""
+	for path, exists := range suppliedFiles {
+		if !exists || !strings.HasSuffix(filepath.ToSlash(path), suffix) {
+			continue
+		}
+		if match != "" {
+			return ""
+		}
+		match = path
+	}
+	return match
+}
+
+// BuildErlangModuleIndex maps module names to the .erl files that define them.
+// The -module attribute is authoritative; the file name is used when it is missing.
+func BuildErlangModuleIndex(erlangFiles []string, contentReader vcs.ContentReader) map[string][]string {
+	index := make(map[string][]string)
+	for _, path := range erlangFiles {
+		module := strings.TrimSuffix(filepath.Base(path), ".erl")
+		if content, err := contentReader(path); err == nil {
+			if declared := ParseErlangSource(content).Module; declared != "" {
+				module = declared
+			}
+		}
+		index[module] = append(index[module], path)
+	}
+	return index
+}
+
+func collectErlangSourceFiles(suppliedFiles map[string]bool) []string {
+	var erlangFiles []string
+	for path, exists := range suppliedFiles {
+		if exists && filepath.Ext(path) == ".erl" {
+			erlangFiles = append(erlangFiles, path)
+		}
+	}
+	sort.Strings(erlangFiles)
+	return erlangFiles
+}
@@ -0,0 +1,54 @@
+package erlang_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_ErlangResolvesIncludesAndRemoteCalls(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	headerPath := filepath.Join(tmpDir, "apps", "session", "include", "session.hrl")
+	storePath := filepath.Join(tmpDir, "apps", "session", "src", "session_store.erl")
+	serverPath := filepath.Join(tmpDir, "apps", "session", "src", "session_server.erl")
+	suitePath := filepath.Join(tmpDir, "apps", "session", "test", "session_SUITE.erl")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(headerPath), 0o755))
+	require.NoError(t, os.WriteFile(headerPath, []byte("-record(session, {id}).\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(storePath), 0o755))
+	require.NoError(t, os.WriteFile(storePath, []byte("-module(session_store).\n-export([new/1]).\nnew(_) -> ok.\n"), 0o644))
+	require.NoError(t, os.WriteFile(serverPath, []byte(`-module(session_server).
+-include("session.hrl").
+
+init(Args) -> session_store:new(Args).
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(suitePath), 0o755))
+	require.NoError(t, os.WriteFile(suitePath, []byte(`-module(session_SUITE).
+-include_lib("common_test/include/ct.hrl").
+
+all() -> [start].
+start(_) -> ok = session_server:init([]).
+`), 0o644))
+
+	files := []string{headerPath, storePath, serverPath, suitePath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.ElementsMatch(t, []string{headerPath, storePath}, adj[serverPath])
+	assert.Equal(t, []string{serverPath}, adj[suitePath])
+	assert.Empty(t, adj[storePath])
+}
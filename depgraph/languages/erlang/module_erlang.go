@@ -0,0 +1,46 @@
+package erlang
+
+import (
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+type Module struct{}
+
+func (Module) Name() string {
+	return "Erlang"
+}
+
+func (Module) Extensions() []string {
+	return []string{".erl", ".hrl"}
+}
+
+func (Module) Maturity() moduleapi.MaturityLevel {
+	return moduleapi.MaturityUntested
+}
+
+func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
+	return resolver{
+		ctx:           ctx,
+		contentReader: contentReader,
+		moduleIndex:   BuildErlangModuleIndex(collectErlangSourceFiles(ctx.SuppliedFiles), contentReader),
+	}
+}
+
+func (Module) IsTestFile(filePath string, _ vcs.ContentReader) bool {
+	return IsTestFile(filePath)
+}
+
+type resolver struct {
+	ctx           *moduleapi.Context
+	contentReader vcs.ContentReader
+	moduleIndex   map[string][]string
+}
+
+func (r resolver) ResolveProjectImports(absPath, filePath, _ string) ([]string, error) {
+	return ResolveErlangProjectImports(absPath, filePath, r.moduleIndex, r.ctx.SuppliedFiles, r.contentReader)
+}
+
+func (resolver) FinalizeGraph(_ moduleapi.Graph) error {
+	return nil
+}
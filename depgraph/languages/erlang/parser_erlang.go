@@ -0,0 +1,153 @@
+package erlang
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// IncludeKind distinguishes -include from -include_lib.
+type IncludeKind int
+
+const (
+	// IncludeLocal is `-include("path.hrl")`, resolved relative to the including file.
+	IncludeLocal IncludeKind = iota
+	// IncludeLib is `-include_lib("app/include/path.hrl")`, resolved through an application directory.
+	IncludeLib
+)
+
+// ErlangInclude represents an -include or -include_lib attribute.
+type ErlangInclude struct {
+	Path string
+	Kind IncludeKind
+}
+
+// ErlangSource holds the dependency-relevant facts extracted from an Erlang file.
+type ErlangSource struct {
+	Module   string
+	Includes []ErlangInclude
+	// ModuleReferences holds modules used in remote calls (`mod:fun(...)`, `fun mod:fun/1`)
+	// and in -behaviour attributes.
+	ModuleReferences []string
+}
+
+var (
+	erlangModulePattern    = regexp.MustCompile(`^-module\(\s*'?([A-Za-z0-9_@]+)'?\s*\)`)
+	erlangIncludePattern   = regexp.MustCompile(`^-(include|include_lib)\(\s*"([^"]+)"\s*\)`)
+	erlangBehaviourPattern = regexp.MustCompile(`^-behaviou?r\(\s*'?([A-Za-z0-9_@]+)'?\s*\)`)
+	erlangRemoteCall       = regexp.MustCompile(`(?:^|[^A-Za-z0-9_@?'])([a-z][A-Za-z0-9_@]*):[a-z][A-Za-z0-9_@]*\s*[(/]`)
+)
+
+// ErlangImports parses an Erlang file and returns its module, includes and module references.
+func ErlangImports(filePath string) (ErlangSource, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return ErlangSource{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return ParseErlangSource(sourceCode), nil
+}
+
+// ParseErlangSource extracts the module attribute, include attributes, behaviours and
+// remote call targets from Erlang source code. Comments and string literals are ignored.
+func ParseErlangSource(sourceCode []byte) ErlangSource {
+	var source ErlangSource
+	seen := make(map[string]bool)
+	addReference := func(module string) {
+		if module == "" || seen[module] {
+			return
+		}
+		seen[module] = true
+		source.ModuleReferences = append(source.ModuleReferences, module)
+	}
+
+	for _, rawLine := range strings.Split(string(sourceCode), "\n") {
+		line := strings.TrimSpace(stripErlangComment(rawLine))
+		if line == "" {
+			continue
+		}
+
+		if m := erlangModulePattern.FindStringSubmatch(line); m != nil {
+			source.Module = m[1]
+			continue
+		}
+		if m := erlangIncludePattern.FindStringSubmatch(line); m != nil {
+			kind := IncludeLocal
+			if m[1] == "include_lib" {
+				kind = IncludeLib
+			}
+			source.Includes = append(source.Includes, ErlangInclude{Path: m[2], Kind: kind})
+			continue
+		}
+		if m := erlangBehaviourPattern.FindStringSubmatch(line); m != nil {
+			addReference(m[1])
+			continue
+		}
+
+		for _, m := range erlangRemoteCall.FindAllStringSubmatch(stripErlangStrings(line), -1) {
+			addReference(m[1])
+		}
+	}
+
+	if source.Module != "" {
+		filtered := source.ModuleReferences[:0]
+		for _, module := range source.ModuleReferences {
+			if module != source.Module {
+				filtered = append(filtered, module)
+			}
+		}
+		source.ModuleReferences = filtered
+	}
+
+	return source
+}
+
+// stripErlangComment removes a trailing `%` comment, ignoring `%` inside strings and
+// character literals such as `$%`.
+func stripErlangComment(line string) string {
+	inString := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			if inString {
+				i++
+			}
+		case '$':
+			if !inString {
+				i++
+			}
+		case '"':
+			inString = !inString
+		case '%':
+			if !inString {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// stripErlangStrings blanks out double-quoted strings so their content is not
+// mistaken for remote calls.
+func stripErlangStrings(line string) string {
+	var b strings.Builder
+	inString := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if inString {
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+				b.WriteByte('"')
+			}
+			continue
+		}
+		if c == '"' {
+			inString = true
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
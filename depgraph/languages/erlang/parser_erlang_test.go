@@ -0,0 +1,67 @@
+package erlang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErlangSource(t *testing.T) {
+	source := `%% Session server
+-module(session_server).
+-behaviour(gen_server).
+
+-include("session.hrl").
+-include_lib("kernel/include/logger.hrl").
+% -include("ignored.hrl").
+
+init(Args) ->
+    State = session_store:new(Args), % cache:ignored()
+    io:format("not_a:call() ~p~n", [State]),
+    lists:foreach(fun auth:check/1, Args),
+    ?MODULE:handle(State),
+    session_server:loop(State).
+`
+
+	parsed := ParseErlangSource([]byte(source))
+
+	assert.Equal(t, "session_server", parsed.Module)
+	assert.Equal(t, []ErlangInclude{
+		{Path: "session.hrl", Kind: IncludeLocal},
+		{Path: "kernel/include/logger.hrl", Kind: IncludeLib},
+	}, parsed.Includes)
+	assert.Equal(t, []string{"gen_server", "session_store", "io", "lists", "auth"}, parsed.ModuleReferences)
+}
+
+func TestErlangImports_ValidFile(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "app.erl")
+	require.NoError(t, os.WriteFile(tmpFile, []byte("-module(app).\nstart() -> db:start().\n"), 0o644))
+
+	parsed, err := ErlangImports(tmpFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, "app", parsed.Module)
+	assert.Equal(t, []string{"db"}, parsed.ModuleReferences)
+}
+
+func TestResolveErlangIncludePath(t *testing.T) {
+	suppliedFiles := map[string]bool{
+		"/project/apps/session/include/session.hrl":         true,
+		"/project/apps/session/src/local.hrl":               true,
+		"/project/_build/default/lib/chat/include/chat.hrl": true,
+	}
+	source := "/project/apps/session/src/session_server.erl"
+
+	assert.Equal(t, "/project/apps/session/src/local.hrl",
+		ResolveErlangIncludePath(source, ErlangInclude{Path: "local.hrl"}, suppliedFiles))
+	assert.Equal(t, "/project/apps/session/include/session.hrl",
+		ResolveErlangIncludePath(source, ErlangInclude{Path: "session.hrl"}, suppliedFiles))
+	assert.Equal(t, "/project/_build/default/lib/chat/include/chat.hrl",
+		ResolveErlangIncludePath(source, ErlangInclude{Path: "chat/include/chat.hrl", Kind: IncludeLib}, suppliedFiles))
+	assert.Equal(t, "/project/apps/session/include/session.hrl",
+		ResolveErlangIncludePath(source, ErlangInclude{Path: "session-1.0.0/include/session.hrl", Kind: IncludeLib}, suppliedFiles))
+	assert.Empty(t, ResolveErlangIncludePath(source, ErlangInclude{Path: "missing.hrl"}, suppliedFiles))
+}
@@ -0,0 +1,23 @@
+package erlang
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsTestFile reports whether the given Erlang path is a test module.
+// Common Test suites end in _SUITE and EUnit modules end in _tests.
+func IsTestFile(filePath string) bool {
+	fileName := filepath.Base(filePath)
+	if filepath.Ext(fileName) != ".erl" {
+		return false
+	}
+
+	base := strings.TrimSuffix(fileName, ".erl")
+	if strings.HasSuffix(base, "_SUITE") || strings.HasSuffix(base, "_tests") || strings.HasSuffix(base, "_test") {
+		return true
+	}
+
+	slashed := filepath.ToSlash(filePath)
+	return strings.Contains(slashed, "/test/")
+}
@@ -0,0 +1,14 @@
+package erlang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("apps/session/test/session_SUITE.erl"))
+	assert.True(t, IsTestFile("apps/session/src/session_tests.erl"))
+	assert.False(t, IsTestFile("apps/session/src/session_server.erl"))
+	assert.False(t, IsTestFile("apps/session/test/session.hrl"))
+}
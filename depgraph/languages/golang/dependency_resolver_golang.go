@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 )
 
@@ -42,6 +43,35 @@ func (r *ProjectImportResolver) ResolveProjectImports(absPath, filePath string)
 		r.contentReader)
 }
 
+// LocateProjectImports reports the import spec behind each cross-package import. Every Go file of
+// the imported package is reported, since the graph keeps only the files whose symbols are used.
+func (r *ProjectImportResolver) LocateProjectImports(absPath, filePath string) ([]moduleapi.ImportReference, error) {
+	sourceContent, err := r.contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	sites, err := ParseGoImportSites(sourceContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, err)
+	}
+
+	var references []moduleapi.ImportReference
+	for _, site := range sites {
+		packageDir := resolveGoImportPath(absPath, site.Specifier, r.contentReader)
+		if packageDir == "" {
+			continue
+		}
+		for _, depFile := range r.dirToFiles[packageDir] {
+			if depFile != absPath && filepath.Ext(depFile) == ".go" {
+				references = append(references, moduleapi.ImportReference{ImportSite: site, Target: depFile})
+			}
+		}
+	}
+
+	return references, nil
+}
+
 func BuildGoPackageExportIndices(dirToFiles map[string][]string, contentReader vcs.ContentReader) map[string]GoPackageExportIndex {
 	goPackageExportIndices := make(map[string]GoPackageExportIndex) // packageDir -> export index
 	for dir, files := range dirToFiles {
@@ -22,6 +22,10 @@ func (Module) Maturity() moduleapi.MaturityLevel {
 	return moduleapi.MaturityActivelyTested
 }
 
+func (Module) LocatesImports() bool {
+	return true
+}
+
 func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
 	return resolver{
 		ctx:             ctx,
@@ -44,6 +48,10 @@ func (r resolver) ResolveProjectImports(absPath, filePath, _ string) ([]string,
 	return r.projectResolver.ResolveProjectImports(absPath, filePath)
 }
 
+func (r resolver) LocateProjectImports(absPath, filePath, _ string) ([]moduleapi.ImportReference, error) {
+	return r.projectResolver.LocateProjectImports(absPath, filePath)
+}
+
 func (r resolver) FinalizeGraph(graph moduleapi.Graph) error {
 	return addGoIntraPackageDependencies(graph, r.ctx.GoFiles, r.contentReader)
 }
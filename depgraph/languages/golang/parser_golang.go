@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	sitter "github.com/smacker/go-tree-sitter"
 	tsgolang "github.com/smacker/go-tree-sitter/golang"
 )
@@ -151,6 +152,50 @@ func queryGoImports(rootNode *sitter.Node, sourceCode []byte, pattern string) ([
 	return imports, nil
 }
 
+// ParseGoImportSites parses Go source code and returns the position and path of every import spec.
+func ParseGoImportSites(sourceCode []byte) ([]moduleapi.ImportSite, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(tsgolang.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Go code: %w", err)
+	}
+	defer tree.Close()
+
+	var sites []moduleapi.ImportSite
+	var walk func(*sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if n.Type() == "import_spec" {
+			if path := n.ChildByFieldName("path"); path != nil {
+				if importPath := cleanGoImportPath(path.Content(sourceCode)); importPath != "" {
+					sites = append(sites, importSiteAt(n, importPath))
+				}
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return sites, nil
+}
+
+// importSiteAt records the 1-based position of an import statement.
+func importSiteAt(node *sitter.Node, specifier string) moduleapi.ImportSite {
+	start := node.StartPoint()
+	return moduleapi.ImportSite{
+		Specifier: specifier,
+		Line:      int(start.Row) + 1,
+		Column:    int(start.Column) + 1,
+	}
+}
+
 // cleanGoImportPath removes quotes and trims whitespace from import paths
 func cleanGoImportPath(raw string) string {
 	// Remove backticks or double quotes
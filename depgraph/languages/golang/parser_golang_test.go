@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -422,3 +423,23 @@ func main() {
 	require.NoError(t, err)
 	assert.Empty(t, embeds)
 }
+
+func TestParseGoImportSites_RecordsSpecPositions(t *testing.T) {
+	source := `package main
+
+import "fmt"
+
+import (
+	"os"
+	cfg "example.com/project/config"
+)
+`
+	sites, err := ParseGoImportSites([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []moduleapi.ImportSite{
+		{Specifier: "fmt", Line: 3, Column: 8},
+		{Specifier: "os", Line: 6, Column: 2},
+		{Specifier: "example.com/project/config", Line: 7, Column: 2},
+	}, sites)
+}
@@ -0,0 +1,86 @@
+package groovy
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// ResolveGroovyProjectImports resolves Groovy imports, same-package references and
+// `apply from` scripts against the shared JVM package index and supplied files.
+func ResolveGroovyProjectImports(
+	absPath string,
+	filePath string,
+	index *moduleapi.JVMIndex,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	source, err := ParseGroovySource(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, err)
+	}
+
+	typeReferences := ExtractTypeIdentifiers(content)
+	referencedTypes := make(map[string]bool, len(typeReferences))
+	for _, ref := range typeReferences {
+		referencedTypes[ref] = true
+	}
+
+	excludedNames := make(map[string]bool)
+	for _, name := range ParseTopLevelTypeNames(content) {
+		excludedNames[name] = true
+	}
+
+	seen := make(map[string]bool)
+	var projectImports []string
+	addFiles := func(files ...string) {
+		for _, file := range files {
+			if file == "" || file == absPath || !suppliedFiles[file] || seen[file] {
+				continue
+			}
+			seen[file] = true
+			projectImports = append(projectImports, file)
+		}
+	}
+
+	for _, script := range source.AppliedScripts {
+		addFiles(resolveAppliedScript(absPath, script))
+	}
+	for _, imp := range source.Imports {
+		addFiles(index.ResolveImport(imp.Path, imp.IsWildcard, referencedTypes)...)
+		if !imp.IsWildcard && !imp.IsStatic {
+			excludedNames[lastSegment(imp.Path)] = true
+		}
+	}
+	addFiles(index.ResolveSamePackageReferences(absPath, typeReferences, excludedNames)...)
+
+	return projectImports, nil
+}
+
+// resolveAppliedScript resolves an `apply from` path relative to the applying script,
+// which is how Gradle resolves it for the project that owns the script.
+func resolveAppliedScript(sourceFile, script string) string {
+	if strings.Contains(script, "://") {
+		return ""
+	}
+	scriptPath := filepath.FromSlash(script)
+	if filepath.IsAbs(scriptPath) {
+		return filepath.Clean(scriptPath)
+	}
+	return filepath.Clean(filepath.Join(filepath.Dir(sourceFile), scriptPath))
+}
+
+func lastSegment(path string) string {
+	if idx := strings.LastIndex(path, "."); idx >= 0 {
+		return path[idx+1:]
+	}
+	return path
+}
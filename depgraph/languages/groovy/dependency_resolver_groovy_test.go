@@ -0,0 +1,67 @@
+package groovy_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_GroovyResolvesImportsToJavaAndSamePackageGroovy(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	userPath := filepath.Join(tmpDir, "src", "main", "java", "com", "acme", "model", "User.java")
+	helperPath := filepath.Join(tmpDir, "src", "main", "groovy", "com", "acme", "build", "Helper.groovy")
+	builderPath := filepath.Join(tmpDir, "src", "main", "groovy", "com", "acme", "build", "Builder.groovy")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(userPath), 0o755))
+	require.NoError(t, os.WriteFile(userPath, []byte("package com.acme.model;\n\npublic class User {}\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(helperPath), 0o755))
+	require.NoError(t, os.WriteFile(helperPath, []byte("package com.acme.build\n\nclass Helper {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(builderPath, []byte(`package com.acme.build
+
+import com.acme.model.User
+
+class Builder {
+  User user = new User()
+  def run() { Helper.go() }
+}
+`), 0o644))
+
+	files := []string{userPath, helperPath, builderPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.ElementsMatch(t, []string{userPath, helperPath}, adj[builderPath])
+}
+
+func TestBuildDependencyGraph_GradleScriptResolvesApplyFrom(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	buildPath := filepath.Join(tmpDir, "app", "build.gradle")
+	commonPath := filepath.Join(tmpDir, "gradle", "common.gradle")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(buildPath), 0o755))
+	require.NoError(t, os.WriteFile(buildPath, []byte("apply from: '../gradle/common.gradle'\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(commonPath), 0o755))
+	require.NoError(t, os.WriteFile(commonPath, []byte("ext { versionCode = 1 }\n"), 0o644))
+
+	files := []string{buildPath, commonPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.Equal(t, []string{commonPath}, adj[buildPath])
+}
@@ -0,0 +1,46 @@
+package groovy
+
+import (
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+type Module struct{}
+
+func (Module) Name() string {
+	return "Groovy"
+}
+
+func (Module) Extensions() []string {
+	return []string{".groovy", ".gradle"}
+}
+
+func (Module) Maturity() moduleapi.MaturityLevel {
+	return moduleapi.MaturityUntested
+}
+
+func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
+	return resolver{
+		ctx:           ctx,
+		contentReader: contentReader,
+		index:         ctx.JVMIndex,
+	}
+}
+
+func (Module) IsTestFile(filePath string, _ vcs.ContentReader) bool {
+	return IsTestFile(filePath)
+}
+
+type resolver struct {
+	ctx           *moduleapi.Context
+	contentReader vcs.ContentReader
+	index         *moduleapi.JVMIndex
+}
+
+func (r resolver) ResolveProjectImports(absPath, filePath, _ string) ([]string, error) {
+	return ResolveGroovyProjectImports(absPath, filePath, r.index, r.ctx.SuppliedFiles, r.contentReader)
+}
+
+func (resolver) FinalizeGraph(_ moduleapi.Graph) error {
+	return nil
+}
@@ -0,0 +1,225 @@
+package groovy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/jvm"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsgroovy "github.com/smacker/go-tree-sitter/groovy"
+)
+
+// GroovyImport represents an import in a Groovy file or Gradle script.
+type GroovyImport struct {
+	Path       string
+	IsWildcard bool
+	IsStatic   bool
+}
+
+// GroovySource holds the dependency-relevant facts extracted from a Groovy file.
+type GroovySource struct {
+	Imports []GroovyImport
+	// AppliedScripts holds the paths of scripts pulled in via `apply from: '...'`.
+	AppliedScripts []string
+}
+
+// GroovyImports parses a Groovy file and returns its imports and applied scripts.
+func GroovyImports(filePath string) (GroovySource, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return GroovySource{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return ParseGroovySource(sourceCode)
+}
+
+// ParseGroovySource parses Groovy source code and extracts imports and `apply from` scripts.
+func ParseGroovySource(sourceCode []byte) (GroovySource, error) {
+	tree, err := parseGroovy(sourceCode)
+	if err != nil {
+		return GroovySource{}, fmt.Errorf("failed to parse Groovy code: %w", err)
+	}
+	defer tree.Close()
+
+	var source GroovySource
+	var walk func(*sitter.Node)
+	walk = func(node *sitter.Node) {
+		switch node.Type() {
+		case "groovy_import":
+			if imp, ok := importFromNode(node, sourceCode); ok {
+				source.Imports = append(source.Imports, imp)
+			}
+			return
+		case "juxt_function_call", "function_call":
+			if script := appliedScriptFromCall(node, sourceCode); script != "" {
+				source.AppliedScripts = append(source.AppliedScripts, script)
+			}
+		}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			walk(node.NamedChild(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return source, nil
+}
+
+func importFromNode(node *sitter.Node, sourceCode []byte) (GroovyImport, bool) {
+	path := node.ChildByFieldName("import")
+	if path == nil {
+		return GroovyImport{}, false
+	}
+
+	imp := GroovyImport{Path: strings.TrimSpace(path.Content(sourceCode))}
+	for i := 0; i < int(node.ChildCount()); i++ {
+		switch node.Child(i).Type() {
+		case "wildcard_import":
+			imp.IsWildcard = true
+		case "modifier":
+			imp.IsStatic = strings.Contains(node.Child(i).Content(sourceCode), "static")
+		}
+	}
+
+	return imp, imp.Path != ""
+}
+
+// appliedScriptFromCall returns the script path of `apply from: 'path'` when it is a plain string.
+func appliedScriptFromCall(node *sitter.Node, sourceCode []byte) string {
+	function := node.ChildByFieldName("function")
+	args := node.ChildByFieldName("args")
+	if function == nil || args == nil || function.Content(sourceCode) != "apply" {
+		return ""
+	}
+
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		item := args.NamedChild(i)
+		if item.Type() != "map_item" {
+			continue
+		}
+		key := item.ChildByFieldName("key")
+		value := item.ChildByFieldName("value")
+		if key == nil || value == nil || key.Content(sourceCode) != "from" || value.Type() != "string" {
+			continue
+		}
+		return plainStringContent(value, sourceCode)
+	}
+
+	return ""
+}
+
+func plainStringContent(node *sitter.Node, sourceCode []byte) string {
+	var content strings.Builder
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "string_content" {
+			// Interpolated paths such as "$rootDir/x.gradle" cannot be resolved statically.
+			return ""
+		}
+		content.WriteString(child.Content(sourceCode))
+	}
+	return content.String()
+}
+
+// ParsePackageDeclaration returns the package declared in a Groovy file.
+func ParsePackageDeclaration(sourceCode []byte) string {
+	tree, err := parseGroovy(sourceCode)
+	if err != nil {
+		return ""
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child.Type() != "groovy_package" {
+			continue
+		}
+		for j := 0; j < int(child.NamedChildCount()); j++ {
+			if name := child.NamedChild(j); name.Type() == "qualified_name" || name.Type() == "identifier" {
+				return strings.TrimSpace(name.Content(sourceCode))
+			}
+		}
+	}
+
+	return ""
+}
+
+// groovyTypeKeywords are the declaration keywords the grammar parses as a plain
+// `declaration` whose type is the keyword itself, for example `enum Color`.
+var groovyTypeKeywords = map[string]bool{
+	"enum":      true,
+	"trait":     true,
+	"interface": true,
+	"record":    true,
+}
+
+// ParseTopLevelTypeNames returns the classes, interfaces, enums and traits declared at the
+// top level of a Groovy file.
+func ParseTopLevelTypeNames(sourceCode []byte) []string {
+	tree, err := parseGroovy(sourceCode)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	var names []string
+	root := tree.RootNode()
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		name := child.ChildByFieldName("name")
+		if name == nil {
+			continue
+		}
+		switch child.Type() {
+		case "class_definition":
+			names = append(names, name.Content(sourceCode))
+		case "declaration":
+			if typeNode := child.ChildByFieldName("type"); typeNode != nil && groovyTypeKeywords[typeNode.Content(sourceCode)] {
+				names = append(names, name.Content(sourceCode))
+			}
+		}
+	}
+
+	return names
+}
+
+// ExtractTypeIdentifiers returns capitalized identifiers referenced in a Groovy file.
+// Groovy's grammar does not distinguish type names from other identifiers, so any
+// UpperCamel identifier outside package and import statements is treated as a type reference.
+func ExtractTypeIdentifiers(sourceCode []byte) []string {
+	tree, err := parseGroovy(sourceCode)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	seen := make(map[string]bool)
+	var identifiers []string
+	var walk func(*sitter.Node)
+	walk = func(node *sitter.Node) {
+		switch node.Type() {
+		case "groovy_package", "groovy_import":
+			return
+		case "identifier":
+			name := node.Content(sourceCode)
+			if jvm.IsUpperCamelIdentifier(name) && !seen[name] {
+				seen[name] = true
+				identifiers = append(identifiers, name)
+			}
+		}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			walk(node.NamedChild(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return identifiers
+}
+
+func parseGroovy(sourceCode []byte) (*sitter.Tree, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(tsgroovy.GetLanguage())
+	return parser.ParseCtx(context.Background(), nil, sourceCode)
+}
@@ -0,0 +1,67 @@
+package groovy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const builderSource = `package com.acme.build
+
+import com.acme.model.User
+import com.acme.util.*
+import static com.acme.Helpers.help
+import com.acme.Other as Alias
+
+class Builder extends Base {
+  User user = new User()
+  def run() { Helper.go(); Alias a }
+}
+enum Color { RED }
+trait Named {}
+
+apply from: 'gradle/common.gradle'
+apply from: "$rootDir/x.gradle"
+`
+
+func TestParseGroovySource(t *testing.T) {
+	source, err := ParseGroovySource([]byte(builderSource))
+
+	require.NoError(t, err)
+	assert.Equal(t, []GroovyImport{
+		{Path: "com.acme.model.User"},
+		{Path: "com.acme.util", IsWildcard: true},
+		{Path: "com.acme.Helpers.help", IsStatic: true},
+		{Path: "com.acme.Other"},
+	}, source.Imports)
+	assert.Equal(t, []string{"gradle/common.gradle"}, source.AppliedScripts)
+}
+
+func TestParsePackageDeclaration(t *testing.T) {
+	assert.Equal(t, "com.acme.build", ParsePackageDeclaration([]byte(builderSource)))
+	assert.Empty(t, ParsePackageDeclaration([]byte("plugins { id 'java' }\n")))
+}
+
+func TestParseTopLevelTypeNames(t *testing.T) {
+	assert.Equal(t, []string{"Builder", "Color", "Named"}, ParseTopLevelTypeNames([]byte(builderSource)))
+}
+
+func TestExtractTypeIdentifiers(t *testing.T) {
+	identifiers := ExtractTypeIdentifiers([]byte(builderSource))
+
+	assert.Subset(t, identifiers, []string{"Base", "User", "Helper", "Alias"})
+	assert.NotContains(t, identifiers, "Other")
+}
+
+func TestGroovyImports_ValidFile(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "build.gradle")
+	require.NoError(t, os.WriteFile(tmpFile, []byte("apply from: 'versions.gradle'\n"), 0o644))
+
+	source, err := GroovyImports(tmpFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"versions.gradle"}, source.AppliedScripts)
+}
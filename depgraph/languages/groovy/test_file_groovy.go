@@ -0,0 +1,25 @@
+package groovy
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsTestFile reports whether the given Groovy file path is a test file.
+// Gradle scripts are build configuration and never tests.
+func IsTestFile(filePath string) bool {
+	base := filepath.Base(filePath)
+	if filepath.Ext(base) != ".groovy" {
+		return false
+	}
+
+	name := strings.TrimSuffix(base, ".groovy")
+	for _, suffix := range []string{"Test", "Tests", "Spec"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	slashed := filepath.ToSlash(filePath)
+	return strings.Contains(slashed, "/src/test/") || strings.Contains(slashed, "/test/")
+}
@@ -0,0 +1,14 @@
+package groovy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("app/src/test/groovy/com/acme/BuilderSpec.groovy"))
+	assert.True(t, IsTestFile("app/src/integration/groovy/com/acme/BuilderTest.groovy"))
+	assert.False(t, IsTestFile("app/src/main/groovy/com/acme/Builder.groovy"))
+	assert.False(t, IsTestFile("app/src/test/build.gradle"))
+}
@@ -20,7 +20,14 @@ func (Module) Maturity() moduleapi.MaturityLevel {
 }
 
 func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
-	packageIndex, packageTypes, filePackages := BuildJavaIndices(ctx.JavaFiles, contentReader)
+	var packageIndex map[string][]string
+	var packageTypes map[string]map[string][]string
+	var filePackages map[string]string
+	if ctx.JVMIndex != nil {
+		packageIndex, packageTypes, filePackages = ctx.JVMIndex.Maps()
+	} else {
+		packageIndex, packageTypes, filePackages = BuildJavaIndices(ctx.JavaFiles, contentReader)
+	}
 	return resolver{
 		ctx:           ctx,
 		contentReader: contentReader,
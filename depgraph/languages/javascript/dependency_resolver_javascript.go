@@ -3,6 +3,7 @@ package javascript
 import (
 	"fmt"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 )
 
@@ -33,3 +34,33 @@ func ResolveJavaScriptProjectImports(
 
 	return projectImports, nil
 }
+
+// LocateJavaScriptProjectImports reports the import statement behind each project import.
+func LocateJavaScriptProjectImports(
+	absPath string,
+	filePath string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]moduleapi.ImportReference, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	sites, parseErr := ParseJavaScriptImportSites(content)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, parseErr)
+	}
+
+	var references []moduleapi.ImportReference
+	for _, site := range sites {
+		if _, ok := classifyJavaScriptImport(site.Specifier, false).(InternalImport); !ok {
+			continue
+		}
+		for _, target := range ResolveJavaScriptImportPath(absPath, site.Specifier, suppliedFiles) {
+			references = append(references, moduleapi.ImportReference{ImportSite: site, Target: target})
+		}
+	}
+
+	return references, nil
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/javascript"
 )
@@ -150,6 +151,65 @@ func ParseJavaScriptImports(sourceCode []byte, isJSX bool) ([]JavaScriptImport,
 	return extractImportsFromTree(tree.RootNode(), sourceCode, lang)
 }
 
+// ParseJavaScriptImportSites parses JavaScript source code and returns the position and
+// specifier of every import, re-export and require call.
+func ParseJavaScriptImportSites(sourceCode []byte) ([]moduleapi.ImportSite, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(javascript.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JavaScript code: %w", err)
+	}
+	defer tree.Close()
+
+	var sites []moduleapi.ImportSite
+	var walk func(*sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "import_statement", "export_statement":
+			if source := n.ChildByFieldName("source"); source != nil {
+				if importPath := cleanImportPath(source.Content(sourceCode)); importPath != "" {
+					sites = append(sites, importSiteAt(n, importPath))
+				}
+			}
+		case "call_expression":
+			function := n.ChildByFieldName("function")
+			arguments := n.ChildByFieldName("arguments")
+			if function != nil && arguments != nil && function.Type() == "identifier" && function.Content(sourceCode) == "require" {
+				for i := 0; i < int(arguments.NamedChildCount()); i++ {
+					arg := arguments.NamedChild(i)
+					if arg.Type() != "string" {
+						continue
+					}
+					if importPath := cleanImportPath(arg.Content(sourceCode)); importPath != "" {
+						sites = append(sites, importSiteAt(n, importPath))
+					}
+				}
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return sites, nil
+}
+
+// importSiteAt records the 1-based position of an import statement.
+func importSiteAt(node *sitter.Node, specifier string) moduleapi.ImportSite {
+	start := node.StartPoint()
+	return moduleapi.ImportSite{
+		Specifier: specifier,
+		Line:      int(start.Row) + 1,
+		Column:    int(start.Column) + 1,
+	}
+}
+
 // extractImportsFromTree walks the AST and extracts imports
 func extractImportsFromTree(rootNode *sitter.Node, sourceCode []byte, lang *sitter.Language) ([]JavaScriptImport, error) {
 	var imports []JavaScriptImport
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -209,3 +210,18 @@ func assertImportType(t *testing.T, imports []JavaScriptImport, path string, exp
 	}
 	t.Errorf("Import with path %s not found", path)
 }
+
+func TestParseJavaScriptImportSites_IncludesRequireCalls(t *testing.T) {
+	source := `import { a } from './a';
+const b = require('./b');
+export * from './c';
+`
+	sites, err := ParseJavaScriptImportSites([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []moduleapi.ImportSite{
+		{Specifier: "./a", Line: 1, Column: 1},
+		{Specifier: "./b", Line: 2, Column: 11},
+		{Specifier: "./c", Line: 3, Column: 1},
+	}, sites)
+}
@@ -0,0 +1,77 @@
+package jvm
+
+import (
+	"path/filepath"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// DeclarationParser extracts the package and top-level type names declared in a JVM source file.
+type DeclarationParser struct {
+	Package       func(sourceCode []byte) string
+	TopLevelTypes func(sourceCode []byte) []string
+}
+
+// BuildIndex builds a package index for the given files, choosing a parser by file extension.
+// Files without a parser or without a package declaration are skipped.
+func BuildIndex(files []string, contentReader vcs.ContentReader, parsers map[string]DeclarationParser) *moduleapi.JVMIndex {
+	index := &moduleapi.JVMIndex{
+		PackageFiles: make(map[string][]string),
+		PackageTypes: make(map[string]map[string][]string),
+		FilePackages: make(map[string]string),
+	}
+
+	sortedFiles := append([]string(nil), files...)
+	sort.Strings(sortedFiles)
+
+	for _, filePath := range sortedFiles {
+		parser, ok := parsers[filepath.Ext(filePath)]
+		if !ok {
+			continue
+		}
+
+		absPath, err := filepath.Abs(filePath)
+		if err != nil {
+			continue
+		}
+
+		content, err := contentReader(absPath)
+		if err != nil {
+			continue
+		}
+
+		pkg := parser.Package(content)
+		if pkg == "" {
+			continue
+		}
+		index.FilePackages[absPath] = pkg
+		index.PackageFiles[pkg] = append(index.PackageFiles[pkg], absPath)
+
+		typeMap, ok := index.PackageTypes[pkg]
+		if !ok {
+			typeMap = make(map[string][]string)
+			index.PackageTypes[pkg] = typeMap
+		}
+		for _, typeName := range parser.TopLevelTypes(content) {
+			if typeName == "" {
+				continue
+			}
+			typeMap[typeName] = append(typeMap[typeName], absPath)
+		}
+	}
+
+	return index
+}
+
+// IsUpperCamelIdentifier reports whether name looks like a JVM type name.
+func IsUpperCamelIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
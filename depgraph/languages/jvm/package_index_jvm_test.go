@@ -0,0 +1,89 @@
+package jvm
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeParser() DeclarationParser {
+	return DeclarationParser{
+		Package: func(sourceCode []byte) string {
+			pkg, _, _ := strings.Cut(string(sourceCode), ":")
+			return pkg
+		},
+		TopLevelTypes: func(sourceCode []byte) []string {
+			_, types, _ := strings.Cut(string(sourceCode), ":")
+			return strings.Fields(types)
+		},
+	}
+}
+
+func buildTestIndex(t *testing.T, contents map[string]string) *moduleapi.JVMIndex {
+	t.Helper()
+	files := make([]string, 0, len(contents))
+	for path := range contents {
+		files = append(files, path)
+	}
+	reader := func(path string) ([]byte, error) {
+		content, ok := contents[path]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return []byte(content), nil
+	}
+	return BuildIndex(files, reader, map[string]DeclarationParser{".java": fakeParser(), ".scala": fakeParser()})
+}
+
+func TestBuildIndex_IndexesAcrossLanguages(t *testing.T) {
+	index := buildTestIndex(t, map[string]string{
+		"/p/User.java":    "com.acme.model:User",
+		"/p/Ledger.scala": "com.acme.billing:Ledger LedgerEntry",
+		"/p/notes.txt":    "com.acme.notes:Note",
+	})
+
+	assert.Equal(t, "/p/User.java", index.TypeFile("com.acme.model", "User"))
+	assert.Equal(t, "/p/Ledger.scala", index.TypeFile("com.acme.billing", "LedgerEntry"))
+	assert.False(t, index.HasPackage("com.acme.notes"))
+	assert.Equal(t, "com.acme.billing", index.FilePackages["/p/Ledger.scala"])
+}
+
+func TestIndex_ResolveImport(t *testing.T) {
+	index := buildTestIndex(t, map[string]string{
+		"/p/User.java":     "com.acme.model:User",
+		"/p/Account.java":  "com.acme.model:Account",
+		"/p/Helpers.scala": "com.acme:Helpers",
+		"/p/a/Dup.java":    "com.acme.dup:Dup",
+		"/p/b/Dup.scala":   "com.acme.dup:Dup",
+	})
+
+	assert.Equal(t, []string{"/p/User.java"}, index.ResolveImport("com.acme.model.User", false, nil))
+	assert.Equal(t, []string{"/p/Helpers.scala"}, index.ResolveImport("com.acme.Helpers.help", false, nil))
+	assert.Equal(t, []string{"/p/Account.java"}, index.ResolveImport("com.acme.model", true, map[string]bool{"Account": true}))
+	assert.Empty(t, index.ResolveImport("com.acme.dup.Dup", false, nil))
+	assert.Empty(t, index.ResolveImport("org.other.Thing", false, nil))
+}
+
+func TestIndex_ResolveSamePackageReferences(t *testing.T) {
+	index := buildTestIndex(t, map[string]string{
+		"/p/User.java":   "com.acme.model:User",
+		"/p/Repo.scala":  "com.acme.model:Repo",
+		"/p/Other.scala": "com.acme.other:Other",
+	})
+
+	deps := index.ResolveSamePackageReferences("/p/Repo.scala", []string{"User", "Repo", "Other"}, map[string]bool{"Repo": true})
+	assert.Equal(t, []string{"/p/User.java"}, deps)
+}
+
+func TestIndex_NilIsEmpty(t *testing.T) {
+	var index *moduleapi.JVMIndex
+
+	packageFiles, packageTypes, filePackages := index.Maps()
+	assert.Nil(t, packageFiles)
+	assert.Nil(t, packageTypes)
+	assert.NotNil(t, filePackages)
+	assert.Empty(t, index.ResolveImport("com.acme.User", false, nil))
+}
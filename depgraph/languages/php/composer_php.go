@@ -0,0 +1,155 @@
+package php
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// PSR4Mapping maps a namespace prefix to the directories that hold its classes.
+type PSR4Mapping struct {
+	Prefix string
+	Dirs   []string
+}
+
+// ComposerAutoload holds the autoload rules declared in a composer.json,
+// with directories resolved to absolute paths.
+type ComposerAutoload struct {
+	Dir string
+	// PSR4 is sorted by descending prefix length so the most specific prefix wins.
+	PSR4     []PSR4Mapping
+	Classmap []string
+}
+
+type composerManifest struct {
+	Autoload    composerAutoloadSection `json:"autoload"`
+	AutoloadDev composerAutoloadSection `json:"autoload-dev"`
+}
+
+type composerAutoloadSection struct {
+	PSR4     map[string]json.RawMessage `json:"psr-4"`
+	Classmap []string                   `json:"classmap"`
+}
+
+// FindComposerAutoload walks up from dir to the nearest composer.json and returns its
+// autoload rules. Returns nil when no readable composer.json is found.
+func FindComposerAutoload(dir string, contentReader vcs.ContentReader) *ComposerAutoload {
+	current := filepath.Clean(dir)
+	for {
+		content, err := contentReader(filepath.Join(current, "composer.json"))
+		if err == nil {
+			autoload, parseErr := ParseComposerAutoload(current, content)
+			if parseErr == nil {
+				return autoload
+			}
+			return nil
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return nil
+		}
+		current = parent
+	}
+}
+
+// ParseComposerAutoload parses the autoload and autoload-dev sections of a composer.json
+// located in composerDir.
+func ParseComposerAutoload(composerDir string, content []byte) (*ComposerAutoload, error) {
+	var manifest composerManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+
+	autoload := &ComposerAutoload{Dir: composerDir}
+	for _, section := range []composerAutoloadSection{manifest.Autoload, manifest.AutoloadDev} {
+		for prefix, raw := range section.PSR4 {
+			dirs := decodePSR4Dirs(raw)
+			if len(dirs) == 0 {
+				continue
+			}
+			mapping := PSR4Mapping{Prefix: strings.Trim(prefix, `\`)}
+			for _, dir := range dirs {
+				mapping.Dirs = append(mapping.Dirs, filepath.Join(composerDir, filepath.FromSlash(dir)))
+			}
+			autoload.PSR4 = append(autoload.PSR4, mapping)
+		}
+		for _, path := range section.Classmap {
+			autoload.Classmap = append(autoload.Classmap, filepath.Join(composerDir, filepath.FromSlash(path)))
+		}
+	}
+
+	sort.SliceStable(autoload.PSR4, func(i, j int) bool {
+		if len(autoload.PSR4[i].Prefix) != len(autoload.PSR4[j].Prefix) {
+			return len(autoload.PSR4[i].Prefix) > len(autoload.PSR4[j].Prefix)
+		}
+		return autoload.PSR4[i].Prefix < autoload.PSR4[j].Prefix
+	})
+
+	return autoload, nil
+}
+
+// decodePSR4Dirs accepts both the string and array forms of a PSR-4 mapping value.
+func decodePSR4Dirs(raw json.RawMessage) []string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err == nil {
+		return multiple
+	}
+
+	return nil
+}
+
+// PSR4Candidates returns the file paths where the PSR-4 rules expect the given
+// fully-qualified class name to live, most specific prefix first.
+func (c *ComposerAutoload) PSR4Candidates(fqcn string) []string {
+	if c == nil {
+		return nil
+	}
+
+	var candidates []string
+	for _, mapping := range c.PSR4 {
+		rest, ok := trimNamespacePrefix(fqcn, mapping.Prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		relative := filepath.FromSlash(strings.ReplaceAll(rest, `\`, "/")) + ".php"
+		for _, dir := range mapping.Dirs {
+			candidates = append(candidates, filepath.Join(dir, relative))
+		}
+	}
+
+	return candidates
+}
+
+// InClassmap reports whether filePath lies under one of the classmap paths.
+func (c *ComposerAutoload) InClassmap(filePath string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, path := range c.Classmap {
+		if filePath == path || strings.HasPrefix(filePath, path+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func trimNamespacePrefix(fqcn, prefix string) (string, bool) {
+	if prefix == "" {
+		return fqcn, true
+	}
+	if len(fqcn) <= len(prefix) || !strings.EqualFold(fqcn[:len(prefix)], prefix) || fqcn[len(prefix)] != '\\' {
+		return "", false
+	}
+	return fqcn[len(prefix)+1:], true
+}
@@ -0,0 +1,161 @@
+package php
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+func ResolvePHPProjectImports(
+	absPath string,
+	filePath string,
+	composer *ComposerAutoload,
+	classIndex map[string][]string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	source, parseErr := ParsePHPSource(content)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, parseErr)
+	}
+
+	seen := make(map[string]bool)
+	var projectImports []string
+	add := func(path string) {
+		if path == "" || path == absPath || seen[path] {
+			return
+		}
+		seen[path] = true
+		projectImports = append(projectImports, path)
+	}
+
+	for _, inc := range source.Includes {
+		add(ResolvePHPIncludePath(absPath, inc, composer, suppliedFiles))
+	}
+	for _, fqcn := range source.ClassReferences {
+		add(ResolvePHPClassPath(fqcn, composer, classIndex, suppliedFiles))
+	}
+
+	return projectImports, nil
+}
+
+// ResolvePHPClassPath resolves a fully-qualified class name to a supplied file.
+// PSR-4 rules are consulted first, then classmap entries, then the class index of
+// supplied files. Returns an empty string when resolution is ambiguous.
+func ResolvePHPClassPath(fqcn string, composer *ComposerAutoload, classIndex map[string][]string, suppliedFiles map[string]bool) string {
+	for _, candidate := range composer.PSR4Candidates(fqcn) {
+		if suppliedFiles[candidate] {
+			return candidate
+		}
+	}
+
+	declaringFiles := classIndex[strings.ToLower(fqcn)]
+
+	var classmapped []string
+	for _, path := range declaringFiles {
+		if composer.InClassmap(path) {
+			classmapped = append(classmapped, path)
+		}
+	}
+	if len(classmapped) == 1 {
+		return classmapped[0]
+	}
+
+	if len(declaringFiles) == 1 {
+		return declaringFiles[0]
+	}
+
+	return ""
+}
+
+// ResolvePHPIncludePath resolves a require/include path to a supplied file.
+func ResolvePHPIncludePath(sourceFile string, inc IncludeImport, composer *ComposerAutoload, suppliedFiles map[string]bool) string {
+	includePath := filepath.FromSlash(inc.Path)
+	sourceDir := filepath.Dir(sourceFile)
+
+	if inc.RelativeToFile || strings.HasPrefix(inc.Path, "./") || strings.HasPrefix(inc.Path, "../") {
+		candidate := filepath.Clean(filepath.Join(sourceDir, includePath))
+		if suppliedFiles[candidate] {
+			return candidate
+		}
+		return ""
+	}
+
+	if filepath.IsAbs(includePath) {
+		if suppliedFiles[filepath.Clean(includePath)] {
+			return filepath.Clean(includePath)
+		}
+		return ""
+	}
+
+	// Bare include paths go through include_path, which usually contains the
+	// current directory and the project root.
+	bases := []string{sourceDir}
+	if composer != nil {
+		bases = append(bases, composer.Dir)
+	}
+	for _, base := range bases {
+		candidate := filepath.Clean(filepath.Join(base, includePath))
+		if suppliedFiles[candidate] {
+			return candidate
+		}
+	}
+
+	return resolveUniqueSuffix(filepath.ToSlash(filepath.Clean(includePath)), suppliedFiles)
+}
+
+func resolveUniqueSuffix(suffix string, suppliedFiles map[string]bool) string {
+	match := ""
+	for path, exists := range suppliedFiles {
+		if !exists || filepath.Ext(path) != ".php" {
+			continue
+		}
+		if !strings.HasSuffix(filepath.ToSlash(path), "/"+suffix) {
+			continue
+		}
+		if match != "" {
+			return ""
+		}
+		match = path
+	}
+	return match
+}
+
+// BuildPHPClassIndex maps lower-cased fully-qualified type names to the files that declare them.
+func BuildPHPClassIndex(phpFiles []string, contentReader vcs.ContentReader) map[string][]string {
+	index := make(map[string][]string)
+	for _, path := range phpFiles {
+		content, err := contentReader(path)
+		if err != nil {
+			continue
+		}
+		source, err := ParsePHPSource(content)
+		if err != nil {
+			continue
+		}
+		for _, declared := range source.DeclaredTypes {
+			key := strings.ToLower(declared)
+			index[key] = append(index[key], path)
+		}
+	}
+	return index
+}
+
+func collectPHPFiles(suppliedFiles map[string]bool) []string {
+	var phpFiles []string
+	for path, exists := range suppliedFiles {
+		if exists && filepath.Ext(path) == ".php" {
+			phpFiles = append(phpFiles, path)
+		}
+	}
+	sort.Strings(phpFiles)
+	return phpFiles
+}
@@ -0,0 +1,99 @@
+package php_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_PHPResolvesComposerPSR4Classes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "composer.json"), []byte(`{
+  "autoload": {"psr-4": {"App\\": "src/"}},
+  "autoload-dev": {"psr-4": {"Tests\\": "tests/"}}
+}`), 0o644))
+
+	userPath := filepath.Join(tmpDir, "src", "Models", "User.php")
+	controllerPath := filepath.Join(tmpDir, "src", "Http", "UserController.php")
+	testPath := filepath.Join(tmpDir, "tests", "Http", "UserControllerTest.php")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(userPath), 0o755))
+	require.NoError(t, os.WriteFile(userPath, []byte("<?php\nnamespace App\\Models;\n\nclass User {}\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(controllerPath), 0o755))
+	require.NoError(t, os.WriteFile(controllerPath, []byte(`<?php
+namespace App\Http;
+
+use App\Models\User;
+
+class UserController
+{
+    public function show(): User
+    {
+        return new User();
+    }
+}
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(testPath), 0o755))
+	require.NoError(t, os.WriteFile(testPath, []byte(`<?php
+namespace Tests\Http;
+
+use App\Http\UserController;
+use PHPUnit\Framework\TestCase;
+
+class UserControllerTest extends TestCase
+{
+}
+`), 0o644))
+
+	files := []string{userPath, controllerPath, testPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.Equal(t, []string{userPath}, adj[controllerPath])
+	assert.Equal(t, []string{controllerPath}, adj[testPath])
+	assert.Empty(t, adj[userPath])
+}
+
+func TestBuildDependencyGraph_PHPResolvesClassmapAndIncludes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "composer.json"), []byte(`{"autoload": {"classmap": ["lib/"]}}`), 0o644))
+
+	legacyPath := filepath.Join(tmpDir, "lib", "legacy_helpers.php")
+	duplicatePath := filepath.Join(tmpDir, "scratch", "legacy_copy.php")
+	bootstrapPath := filepath.Join(tmpDir, "bootstrap.php")
+	indexPath := filepath.Join(tmpDir, "public", "index.php")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(legacyPath), 0o755))
+	require.NoError(t, os.WriteFile(legacyPath, []byte("<?php\nclass LegacyHelper {}\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(duplicatePath), 0o755))
+	require.NoError(t, os.WriteFile(duplicatePath, []byte("<?php\nclass LegacyHelper {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(bootstrapPath, []byte("<?php\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(indexPath), 0o755))
+	require.NoError(t, os.WriteFile(indexPath, []byte(`<?php
+require_once __DIR__ . '/../bootstrap.php';
+
+LegacyHelper::boot();
+`), 0o644))
+
+	files := []string{legacyPath, duplicatePath, bootstrapPath, indexPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.ElementsMatch(t, []string{bootstrapPath, legacyPath}, adj[indexPath])
+}
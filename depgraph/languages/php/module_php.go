@@ -0,0 +1,61 @@
+package php
+
+import (
+	"path/filepath"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+type Module struct{}
+
+func (Module) Name() string {
+	return "PHP"
+}
+
+func (Module) Extensions() []string {
+	return []string{".php"}
+}
+
+func (Module) Maturity() moduleapi.MaturityLevel {
+	return moduleapi.MaturityUntested
+}
+
+func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
+	return resolver{
+		ctx:           ctx,
+		contentReader: contentReader,
+		classIndex:    BuildPHPClassIndex(collectPHPFiles(ctx.SuppliedFiles), contentReader),
+		composerCache: make(map[string]*ComposerAutoload),
+	}
+}
+
+func (Module) IsTestFile(filePath string, contentReader vcs.ContentReader) bool {
+	return IsTestFileWithContent(filePath, contentReader)
+}
+
+type resolver struct {
+	ctx           *moduleapi.Context
+	contentReader vcs.ContentReader
+	classIndex    map[string][]string
+	composerCache map[string]*ComposerAutoload
+}
+
+func (r resolver) ResolveProjectImports(absPath, filePath, _ string) ([]string, error) {
+	composer := r.findComposerAutoload(absPath)
+	return ResolvePHPProjectImports(absPath, filePath, composer, r.classIndex, r.ctx.SuppliedFiles, r.contentReader)
+}
+
+func (resolver) FinalizeGraph(_ moduleapi.Graph) error {
+	return nil
+}
+
+func (r resolver) findComposerAutoload(absPath string) *ComposerAutoload {
+	dir := filepath.Dir(absPath)
+	if cached, ok := r.composerCache[dir]; ok {
+		return cached
+	}
+	composer := FindComposerAutoload(dir, r.contentReader)
+	r.composerCache[dir] = composer
+	return composer
+}
@@ -0,0 +1,411 @@
+package php
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/php"
+)
+
+// UseImport represents a `use` statement importing a class, interface, trait or enum.
+type UseImport struct {
+	Name  string
+	Alias string
+}
+
+// IncludeImport represents a require/include expression with a statically known path.
+type IncludeImport struct {
+	Path string
+	// RelativeToFile is true when the path is anchored at the including file's
+	// directory, for example `__DIR__ . '/bootstrap.php'`.
+	RelativeToFile bool
+}
+
+// PHPSource holds the dependency-relevant facts extracted from a PHP file.
+type PHPSource struct {
+	Namespace string
+	Uses      []UseImport
+	Includes  []IncludeImport
+	// ClassReferences holds fully-qualified names of classes referenced via `use`,
+	// `new`, static access, extends/implements, trait use, type hints and instanceof.
+	ClassReferences []string
+	// DeclaredTypes holds fully-qualified names of classes, interfaces, traits and enums
+	// declared in the file.
+	DeclaredTypes []string
+}
+
+// PHPImports parses a PHP file and returns its dependency-relevant facts.
+func PHPImports(filePath string) (PHPSource, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return PHPSource{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return ParsePHPSource(sourceCode)
+}
+
+// ParsePHPSource parses PHP source code and extracts namespaces, use statements,
+// include expressions, class references and type declarations.
+func ParsePHPSource(sourceCode []byte) (PHPSource, error) {
+	lang := php.GetLanguage()
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(lang)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return PHPSource{}, fmt.Errorf("failed to parse PHP code: %w", err)
+	}
+	defer tree.Close()
+
+	extractor := &phpExtractor{
+		sourceCode: sourceCode,
+		aliases:    make(map[string]string),
+		seenRefs:   make(map[string]bool),
+	}
+	extractor.walk(tree.RootNode())
+
+	return extractor.result, nil
+}
+
+type phpExtractor struct {
+	sourceCode []byte
+	result     PHPSource
+	// aliases maps lower-cased import aliases to fully-qualified names.
+	aliases  map[string]string
+	seenRefs map[string]bool
+}
+
+func (e *phpExtractor) walk(n *sitter.Node) {
+	if n == nil {
+		return
+	}
+
+	switch n.Type() {
+	case "namespace_definition":
+		if name := n.ChildByFieldName("name"); name != nil {
+			e.result.Namespace = name.Content(e.sourceCode)
+			e.aliases = make(map[string]string)
+		}
+	case "namespace_use_declaration":
+		e.collectUseDeclaration(n)
+		return
+	case "require_expression", "require_once_expression", "include_expression", "include_once_expression":
+		if inc, ok := e.includeFromNode(n); ok {
+			e.result.Includes = append(e.result.Includes, inc)
+		}
+		return
+	case "class_declaration", "interface_declaration", "trait_declaration", "enum_declaration":
+		if name := n.ChildByFieldName("name"); name != nil {
+			e.result.DeclaredTypes = append(e.result.DeclaredTypes, e.qualify(name.Content(e.sourceCode)))
+		}
+	case "object_creation_expression", "base_clause", "class_interface_clause", "use_declaration", "named_type":
+		e.collectNameChildren(n)
+	case "scoped_call_expression", "scoped_property_access_expression", "class_constant_access_expression":
+		if scope := n.ChildByFieldName("scope"); scope != nil {
+			e.addReference(scope)
+		} else if n.NamedChildCount() > 0 {
+			e.addReference(n.NamedChild(0))
+		}
+	case "binary_expression":
+		if operator := n.ChildByFieldName("operator"); operator != nil && operator.Type() == "instanceof" {
+			e.addReference(n.ChildByFieldName("right"))
+		}
+	}
+
+	for i := 0; i < int(n.ChildCount()); i++ {
+		e.walk(n.Child(i))
+	}
+}
+
+func (e *phpExtractor) collectUseDeclaration(n *sitter.Node) {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		if child == nil {
+			continue
+		}
+		// `use function` and `use const` import functions and constants, which are not autoloaded.
+		if child.Type() == "function" || child.Type() == "const" {
+			return
+		}
+	}
+
+	prefix := ""
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		switch child.Type() {
+		case "namespace_name":
+			prefix = child.Content(e.sourceCode)
+		case "namespace_use_clause":
+			e.addUse("", child)
+		case "namespace_use_group":
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				clause := child.NamedChild(j)
+				if clause.Type() == "namespace_use_group_clause" {
+					e.addUse(prefix, clause)
+				}
+			}
+		}
+	}
+}
+
+func (e *phpExtractor) addUse(prefix string, clause *sitter.Node) {
+	name := ""
+	alias := ""
+	for i := 0; i < int(clause.NamedChildCount()); i++ {
+		child := clause.NamedChild(i)
+		switch child.Type() {
+		case "qualified_name", "namespace_name", "name":
+			if name == "" {
+				name = child.Content(e.sourceCode)
+			} else if alias == "" {
+				alias = child.Content(e.sourceCode)
+			}
+		case "namespace_aliasing_clause":
+			if child.NamedChildCount() > 0 {
+				alias = child.NamedChild(0).Content(e.sourceCode)
+			}
+		}
+	}
+
+	name = strings.TrimPrefix(name, `\`)
+	if prefix != "" {
+		name = strings.TrimPrefix(prefix, `\`) + `\` + name
+	}
+	if name == "" {
+		return
+	}
+	if alias == "" {
+		alias = lastSegment(name)
+	}
+
+	e.aliases[strings.ToLower(alias)] = name
+	e.result.Uses = append(e.result.Uses, UseImport{Name: name, Alias: alias})
+	e.appendReference(name)
+}
+
+func (e *phpExtractor) collectNameChildren(n *sitter.Node) {
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		e.addReference(n.NamedChild(i))
+	}
+}
+
+func (e *phpExtractor) addReference(n *sitter.Node) {
+	if n == nil {
+		return
+	}
+	if n.Type() != "name" && n.Type() != "qualified_name" {
+		return
+	}
+	if fqcn := e.resolveName(n.Content(e.sourceCode)); fqcn != "" {
+		e.appendReference(fqcn)
+	}
+}
+
+func (e *phpExtractor) appendReference(fqcn string) {
+	if e.seenRefs[fqcn] {
+		return
+	}
+	e.seenRefs[fqcn] = true
+	e.result.ClassReferences = append(e.result.ClassReferences, fqcn)
+}
+
+// resolveName applies PHP name resolution rules to a class name as written in source.
+func (e *phpExtractor) resolveName(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+	if strings.HasPrefix(raw, `\`) {
+		return strings.TrimPrefix(raw, `\`)
+	}
+
+	switch strings.ToLower(raw) {
+	case "self", "static", "parent":
+		return ""
+	}
+
+	first, rest, qualified := strings.Cut(raw, `\`)
+	if imported, ok := e.aliases[strings.ToLower(first)]; ok {
+		if qualified {
+			return imported + `\` + rest
+		}
+		return imported
+	}
+
+	return e.qualify(raw)
+}
+
+func (e *phpExtractor) qualify(name string) string {
+	if e.result.Namespace == "" {
+		return name
+	}
+	return e.result.Namespace + `\` + name
+}
+
+func (e *phpExtractor) includeFromNode(n *sitter.Node) (IncludeImport, bool) {
+	if n.NamedChildCount() == 0 {
+		return IncludeImport{}, false
+	}
+
+	expr := n.NamedChild(0)
+	for expr != nil && expr.Type() == "parenthesized_expression" && expr.NamedChildCount() > 0 {
+		expr = expr.NamedChild(0)
+	}
+	if expr == nil {
+		return IncludeImport{}, false
+	}
+
+	switch expr.Type() {
+	case "string", "encapsed_string":
+		path := stringLiteralContent(expr, e.sourceCode)
+		if path == "" {
+			return IncludeImport{}, false
+		}
+		return IncludeImport{Path: path}, true
+	case "binary_expression":
+		left := expr.ChildByFieldName("left")
+		right := expr.ChildByFieldName("right")
+		if left == nil || right == nil || !isCurrentDirectoryExpression(left, e.sourceCode) {
+			return IncludeImport{}, false
+		}
+		if right.Type() != "string" && right.Type() != "encapsed_string" {
+			return IncludeImport{}, false
+		}
+		path := stringLiteralContent(right, e.sourceCode)
+		if path == "" {
+			return IncludeImport{}, false
+		}
+		return IncludeImport{Path: path, RelativeToFile: true}, true
+	default:
+		return IncludeImport{}, false
+	}
+}
+
+// isCurrentDirectoryExpression reports whether the node is `__DIR__` or `dirname(__FILE__)`.
+func isCurrentDirectoryExpression(n *sitter.Node, sourceCode []byte) bool {
+	content := strings.ReplaceAll(n.Content(sourceCode), " ", "")
+	return content == "__DIR__" || content == "dirname(__FILE__)"
+}
+
+// stringLiteralContent returns the value of a quoted string literal with its escape sequences
+// applied, or "" when the string cannot be resolved statically.
+func stringLiteralContent(n *sitter.Node, sourceCode []byte) string {
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		switch n.NamedChild(i).Type() {
+		case "string_content", "escape_sequence":
+		default:
+			// Interpolated strings cannot be resolved statically.
+			return ""
+		}
+	}
+
+	content := n.Content(sourceCode)
+	if len(content) < 2 {
+		return ""
+	}
+	quote := content[len(content)-1]
+	if (quote != '"' && quote != '\'') || content[0] != quote {
+		return ""
+	}
+	return unescapeStringLiteral(content[1:len(content)-1], quote)
+}
+
+// doubleQuotedEscapes maps the single-character escape sequences of double-quoted strings.
+var doubleQuotedEscapes = map[byte]byte{
+	'n': '\n', 't': '\t', 'r': '\r', 'v': '\v', 'e': 0x1b, 'f': '\f',
+	'\\': '\\', '$': '$', '"': '"',
+}
+
+// unescapeStringLiteral applies PHP escape sequences to the body of a string quoted with quote.
+// Single-quoted strings only escape \\ and \', while double-quoted strings also support
+// control characters and octal, hex and unicode escapes. Unknown sequences stay as written.
+func unescapeStringLiteral(body string, quote byte) string {
+	var sb strings.Builder
+	for i := 0; i < len(body); i++ {
+		if body[i] != '\\' || i+1 == len(body) {
+			sb.WriteByte(body[i])
+			continue
+		}
+		next := body[i+1]
+		if quote == '\'' {
+			if next == '\\' || next == '\'' {
+				sb.WriteByte(next)
+				i++
+			} else {
+				sb.WriteByte('\\')
+			}
+			continue
+		}
+
+		if unescaped, ok := doubleQuotedEscapes[next]; ok {
+			sb.WriteByte(unescaped)
+			i++
+			continue
+		}
+		if value, length, ok := numericEscape(body[i+1:]); ok {
+			sb.WriteString(value)
+			i += length
+			continue
+		}
+		sb.WriteByte('\\')
+	}
+	return sb.String()
+}
+
+// numericEscape decodes the octal (\101), hex (\x41) or unicode (\u{41}) escape at the start of
+// s, which follows a backslash. length is the number of bytes of s it consumes.
+func numericEscape(s string) (value string, length int, ok bool) {
+	switch {
+	case s[0] >= '0' && s[0] <= '7':
+		end := 1
+		for end < len(s) && end < 3 && s[end] >= '0' && s[end] <= '7' {
+			end++
+		}
+		n, err := strconv.ParseUint(s[:end], 8, 16)
+		if err != nil {
+			return "", 0, false
+		}
+		return string([]byte{byte(n)}), end, true
+	case s[0] == 'x':
+		end := 1
+		for end < len(s) && end < 3 && isHexDigit(s[end]) {
+			end++
+		}
+		if end == 1 {
+			return "", 0, false
+		}
+		n, err := strconv.ParseUint(s[1:end], 16, 8)
+		if err != nil {
+			return "", 0, false
+		}
+		return string([]byte{byte(n)}), end, true
+	case strings.HasPrefix(s, "u{"):
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			return "", 0, false
+		}
+		n, err := strconv.ParseUint(s[2:end], 16, 32)
+		if err != nil {
+			return "", 0, false
+		}
+		return string(rune(n)), end + 1, true
+	default:
+		return "", 0, false
+	}
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func lastSegment(name string) string {
+	if idx := strings.LastIndex(name, `\`); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
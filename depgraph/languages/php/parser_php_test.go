@@ -0,0 +1,153 @@
+package php
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePHPSource(t *testing.T) {
+	source := `<?php
+namespace App\Http;
+
+use App\Models\User;
+use App\Services\{Mailer, Billing\Invoice as Bill};
+use function App\Support\helper;
+use const App\Support\VERSION;
+
+require_once __DIR__ . '/bootstrap.php';
+include 'config/app.php';
+require $dynamic;
+
+final class Controller extends BaseController implements \Contracts\Handler
+{
+    use Loggable;
+
+    public function handle(Request $request): Response
+    {
+        $user = new User();
+        Bill::create($user);
+        if ($request instanceof Json\Request) {
+            return static::json();
+        }
+        return Mailer::VERSION;
+    }
+}
+`
+
+	parsed, err := ParsePHPSource([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, `App\Http`, parsed.Namespace)
+	assert.Equal(t, []UseImport{
+		{Name: `App\Models\User`, Alias: "User"},
+		{Name: `App\Services\Mailer`, Alias: "Mailer"},
+		{Name: `App\Services\Billing\Invoice`, Alias: "Bill"},
+	}, parsed.Uses)
+	assert.Equal(t, []IncludeImport{
+		{Path: "/bootstrap.php", RelativeToFile: true},
+		{Path: "config/app.php"},
+	}, parsed.Includes)
+	assert.Equal(t, []string{`App\Http\Controller`}, parsed.DeclaredTypes)
+	assert.ElementsMatch(t, []string{
+		`App\Models\User`,
+		`App\Services\Mailer`,
+		`App\Services\Billing\Invoice`,
+		`App\Http\BaseController`,
+		`Contracts\Handler`,
+		`App\Http\Loggable`,
+		`App\Http\Request`,
+		`App\Http\Response`,
+		`App\Http\Json\Request`,
+	}, parsed.ClassReferences)
+}
+
+func TestParsePHPSource_IncludePathsApplyEscapeSequences(t *testing.T) {
+	source := `<?php
+require 'lib/it\'s.php';
+require 'C:\\app\lib.php';
+require "lib/\"quoted\".php";
+require "lib/\x41\101\u{42}.php";
+require "lib/\$name.php";
+require "lib/{$name}.php";
+`
+
+	parsed, err := ParsePHPSource([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []IncludeImport{
+		{Path: "lib/it's.php"},
+		{Path: `C:\app\lib.php`},
+		{Path: `lib/"quoted".php`},
+		{Path: "lib/AAB.php"},
+		{Path: "lib/$name.php"},
+	}, parsed.Includes)
+}
+
+func TestParsePHPSource_GlobalNamespace(t *testing.T) {
+	parsed, err := ParsePHPSource([]byte("<?php\ninterface Shape {}\nenum Color {}\n"))
+
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Namespace)
+	assert.Equal(t, []string{"Shape", "Color"}, parsed.DeclaredTypes)
+}
+
+func TestPHPImports_ValidFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "app.php")
+
+	err := os.WriteFile(tmpFile, []byte("<?php\nuse Vendor\\Package\\Client;\n"), 0644)
+	require.NoError(t, err)
+
+	parsed, err := PHPImports(tmpFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{`Vendor\Package\Client`}, parsed.ClassReferences)
+}
+
+func TestParseComposerAutoload(t *testing.T) {
+	content := `{
+  "autoload": {
+    "psr-4": {"App\\": "src/", "App\\Legacy\\": ["legacy/", "lib/"]},
+    "classmap": ["database/"]
+  },
+  "autoload-dev": {
+    "psr-4": {"Tests\\": "tests/"}
+  }
+}`
+
+	autoload, err := ParseComposerAutoload("/project", []byte(content))
+
+	require.NoError(t, err)
+	assert.Equal(t, []PSR4Mapping{
+		{Prefix: `App\Legacy`, Dirs: []string{"/project/legacy", "/project/lib"}},
+		{Prefix: "Tests", Dirs: []string{"/project/tests"}},
+		{Prefix: "App", Dirs: []string{"/project/src"}},
+	}, autoload.PSR4)
+	assert.Equal(t, []string{"/project/database"}, autoload.Classmap)
+	assert.Equal(t, []string{
+		"/project/legacy/Report.php",
+		"/project/lib/Report.php",
+		"/project/src/Legacy/Report.php",
+	}, autoload.PSR4Candidates(`App\Legacy\Report`))
+	assert.True(t, autoload.InClassmap("/project/database/seeds/UserSeeder.php"))
+	assert.False(t, autoload.InClassmap("/project/databases/Other.php"))
+}
+
+func TestResolvePHPClassPath_FallsBackToUniqueClassIndexEntry(t *testing.T) {
+	suppliedFiles := map[string]bool{
+		"/project/a/Widget.php": true,
+		"/project/b/Widget.php": true,
+		"/project/c/Gadget.php": true,
+	}
+	classIndex := map[string][]string{
+		`shop\widget`: {"/project/a/Widget.php", "/project/b/Widget.php"},
+		`shop\gadget`: {"/project/c/Gadget.php"},
+	}
+
+	assert.Equal(t, "/project/c/Gadget.php", ResolvePHPClassPath(`Shop\Gadget`, nil, classIndex, suppliedFiles))
+	assert.Empty(t, ResolvePHPClassPath(`Shop\Widget`, nil, classIndex, suppliedFiles))
+}
@@ -0,0 +1,48 @@
+package php
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// IsTestFile reports whether the given PHP path is a test file.
+func IsTestFile(filePath string) bool {
+	return IsTestFileWithContent(filePath, nil)
+}
+
+// IsTestFileWithContent reports whether the given PHP path is a test file,
+// using file content when available to detect PHPUnit test cases.
+func IsTestFileWithContent(filePath string, contentReader vcs.ContentReader) bool {
+	fileName := filepath.Base(filePath)
+	ext := filepath.Ext(fileName)
+	if ext != ".php" {
+		return false
+	}
+
+	if strings.HasSuffix(strings.TrimSuffix(fileName, ext), "Test") {
+		return true
+	}
+
+	path := filepath.ToSlash(filePath)
+	inTestDir := strings.Contains(path, "/tests/") || strings.Contains(path, "/test/") ||
+		strings.HasPrefix(path, "tests/") || strings.HasPrefix(path, "test/")
+
+	if contentReader == nil {
+		return inTestDir
+	}
+
+	content, err := contentReader(filePath)
+	if err != nil {
+		return inTestDir
+	}
+
+	return hasPHPUnitContent(content)
+}
+
+func hasPHPUnitContent(content []byte) bool {
+	return bytes.Contains(content, []byte(`PHPUnit\Framework\TestCase`)) ||
+		bytes.Contains(content, []byte("extends TestCase"))
+}
@@ -0,0 +1,34 @@
+package php
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("tests/Unit/UserTest.php"))
+	assert.True(t, IsTestFile("src/UserTest.php"))
+	assert.True(t, IsTestFile("project/tests/bootstrap.php"))
+	assert.False(t, IsTestFile("src/User.php"))
+	assert.False(t, IsTestFile("src/UserTest.js"))
+}
+
+func TestIsTestFileWithContent(t *testing.T) {
+	contents := map[string]string{
+		"tests/Feature/LoginCase.php": "<?php\nuse PHPUnit\\Framework\\TestCase;\nclass LoginCase extends TestCase {}\n",
+		"tests/bootstrap.php":         "<?php\nrequire __DIR__ . '/../vendor/autoload.php';\n",
+	}
+	reader := func(path string) ([]byte, error) {
+		content, ok := contents[path]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return []byte(content), nil
+	}
+
+	assert.True(t, IsTestFileWithContent("tests/Feature/LoginCase.php", reader))
+	assert.False(t, IsTestFileWithContent("tests/bootstrap.php", reader))
+	assert.True(t, IsTestFileWithContent("tests/Missing.php", reader))
+}
@@ -0,0 +1,187 @@
+package protobuf
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// bufConfigFiles lists the buf configuration files that declare proto roots,
+// in the order they are consulted within a directory.
+var bufConfigFiles = []string{"buf.work.yaml", "buf.yaml"}
+
+func ResolveProtoProjectImports(
+	absPath string,
+	filePath string,
+	suppliedFiles map[string]bool,
+	extraRoots []string,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	imports, parseErr := ParseProtoImports(content)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, parseErr)
+	}
+	if len(imports) == 0 {
+		return nil, nil
+	}
+
+	roots := ProtoRoots(absPath, extraRoots, contentReader)
+
+	var projectImports []string
+	for _, imp := range imports {
+		if resolved := ResolveProtoImportPath(imp.Path, roots, suppliedFiles); resolved != "" && resolved != absPath {
+			projectImports = append(projectImports, resolved)
+		}
+	}
+
+	return projectImports, nil
+}
+
+// ResolveProtoImportPath resolves an import path against the given proto roots.
+// Like protoc with multiple -I flags, the first root that contains the file wins.
+func ResolveProtoImportPath(importPath string, roots []string, suppliedFiles map[string]bool) string {
+	cleanImport := filepath.FromSlash(strings.TrimPrefix(filepath.ToSlash(importPath), "/"))
+	if cleanImport == "" {
+		return ""
+	}
+
+	for _, root := range roots {
+		candidate := filepath.Clean(filepath.Join(root, cleanImport))
+		if suppliedFiles[candidate] {
+			return candidate
+		}
+	}
+
+	return ""
+}
+
+// ProtoRoots returns the import roots to search for a .proto file.
+// Roots declared in the nearest buf.work.yaml or buf.yaml come first, then extraRoots,
+// followed by every ancestor directory of the file from nearest to farthest.
+func ProtoRoots(absPath string, extraRoots []string, contentReader vcs.ContentReader) []string {
+	var roots []string
+	seen := make(map[string]bool)
+	addRoot := func(root string) {
+		root = filepath.Clean(root)
+		if seen[root] {
+			return
+		}
+		seen[root] = true
+		roots = append(roots, root)
+	}
+
+	for _, root := range findConfiguredProtoRoots(filepath.Dir(absPath), contentReader) {
+		addRoot(root)
+	}
+	for _, root := range extraRoots {
+		addRoot(root)
+	}
+
+	dir := filepath.Dir(absPath)
+	for {
+		addRoot(dir)
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return roots
+}
+
+// findConfiguredProtoRoots walks up from startDir and returns the roots declared by the
+// first buf configuration file it finds. Returns nil when no configuration declares roots.
+func findConfiguredProtoRoots(startDir string, contentReader vcs.ContentReader) []string {
+	dir := startDir
+	for {
+		for _, name := range bufConfigFiles {
+			content, err := contentReader(filepath.Join(dir, name))
+			if err != nil {
+				continue
+			}
+			relRoots := ParseBufRoots(content)
+			if len(relRoots) == 0 {
+				continue
+			}
+			roots := make([]string, 0, len(relRoots))
+			for _, rel := range relRoots {
+				roots = append(roots, filepath.Join(dir, filepath.FromSlash(rel)))
+			}
+			return roots
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil
+		}
+		dir = parent
+	}
+}
+
+// ParseBufRoots extracts proto roots from buf configuration content.
+// Supported keys are `directories` (buf.work.yaml), `build.roots` (buf.yaml v1)
+// and `modules[].path` (buf.yaml v2).
+func ParseBufRoots(content []byte) []string {
+	var roots []string
+	section := ""
+	sectionIndent := -1
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+
+		if section != "" && indent <= sectionIndent && !strings.HasPrefix(trimmed, "-") {
+			section = ""
+			sectionIndent = -1
+		}
+
+		if key, ok := strings.CutSuffix(trimmed, ":"); ok {
+			switch key {
+			case "directories", "roots", "modules":
+				section = key
+				sectionIndent = indent
+			}
+			continue
+		}
+
+		switch section {
+		case "directories", "roots":
+			if item, ok := strings.CutPrefix(trimmed, "-"); ok {
+				if root := unquoteYAMLScalar(item); root != "" {
+					roots = append(roots, root)
+				}
+			}
+		case "modules":
+			item := strings.TrimSpace(strings.TrimPrefix(trimmed, "-"))
+			if value, ok := strings.CutPrefix(item, "path:"); ok {
+				if root := unquoteYAMLScalar(value); root != "" {
+					roots = append(roots, root)
+				}
+			}
+		}
+	}
+
+	return roots
+}
+
+func unquoteYAMLScalar(raw string) string {
+	return strings.Trim(strings.TrimSpace(raw), "\"'")
+}
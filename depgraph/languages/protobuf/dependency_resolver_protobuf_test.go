@@ -0,0 +1,127 @@
+package protobuf_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_ProtoImportsResolveAgainstAncestorRoots(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	servicePath := filepath.Join(tmpDir, "proto", "payments", "v1", "service.proto")
+	moneyPath := filepath.Join(tmpDir, "proto", "payments", "v1", "money.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(servicePath), 0o755))
+	require.NoError(t, os.WriteFile(servicePath, []byte(`syntax = "proto3";
+import "payments/v1/money.proto";
+import "google/protobuf/timestamp.proto";
+`), 0o644))
+	require.NoError(t, os.WriteFile(moneyPath, []byte(`syntax = "proto3";`), 0o644))
+
+	graph, err := depgraph.BuildDependencyGraph([]string{servicePath, moneyPath}, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.Equal(t, []string{moneyPath}, adj[servicePath])
+	assert.Empty(t, adj[moneyPath])
+}
+
+func TestBuildDependencyGraph_ProtoImportsPreferBufWorkspaceRoots(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "buf.work.yaml"), []byte(`version: v1
+directories:
+  - vendor/proto
+  - proto
+`), 0o644))
+	servicePath := filepath.Join(tmpDir, "proto", "acme", "service.proto")
+	localTypesPath := filepath.Join(tmpDir, "proto", "common", "types.proto")
+	vendoredTypesPath := filepath.Join(tmpDir, "vendor", "proto", "common", "types.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(servicePath), 0o755))
+	require.NoError(t, os.WriteFile(servicePath, []byte(`syntax = "proto3";
+import "common/types.proto";
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(localTypesPath), 0o755))
+	require.NoError(t, os.WriteFile(localTypesPath, []byte(`syntax = "proto3";`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(vendoredTypesPath), 0o755))
+	require.NoError(t, os.WriteFile(vendoredTypesPath, []byte(`syntax = "proto3";`), 0o644))
+
+	graph, err := depgraph.BuildDependencyGraph(
+		[]string{servicePath, localTypesPath, vendoredTypesPath},
+		vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.Equal(t, []string{vendoredTypesPath}, adj[servicePath])
+}
+
+func TestBuildDependencyGraph_ProtoImportsResolveAgainstConfiguredRoots(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	servicePath := filepath.Join(tmpDir, "services", "billing", "service.proto")
+	moneyPath := filepath.Join(tmpDir, "third_party", "protos", "common", "money.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(servicePath), 0o755))
+	require.NoError(t, os.WriteFile(servicePath, []byte(`syntax = "proto3";
+import "common/money.proto";
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(moneyPath), 0o755))
+	require.NoError(t, os.WriteFile(moneyPath, []byte(`syntax = "proto3";`), 0o644))
+	filePaths := []string{servicePath, moneyPath}
+
+	graph, err := depgraph.BuildDependencyGraph(filePaths, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+	assert.Empty(t, mustAdjacency(t, graph)[servicePath])
+
+	built, err := depgraph.BuildDependencyGraphWithOptions(filePaths, vcs.FilesystemContentReader(), depgraph.BuildOptions{
+		ProtoRoots: []string{filepath.Join(tmpDir, "third_party", "protos")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{moneyPath}, mustAdjacency(t, built.Graph)[servicePath])
+}
+
+func TestBuildDependencyGraph_GeneratedFilesLinkToSourceProtoWhenEnabled(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	protoPath := filepath.Join(tmpDir, "proto", "payments.proto")
+	goGenPath := filepath.Join(tmpDir, "gen", "payments.pb.go")
+	pyGenPath := filepath.Join(tmpDir, "gen", "payments_pb2.py")
+	require.NoError(t, os.MkdirAll(filepath.Dir(protoPath), 0o755))
+	require.NoError(t, os.WriteFile(protoPath, []byte(`syntax = "proto3";`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(goGenPath), 0o755))
+	require.NoError(t, os.WriteFile(goGenPath, []byte("package gen\n"), 0o644))
+	require.NoError(t, os.WriteFile(pyGenPath, []byte("DESCRIPTOR = None\n"), 0o644))
+	filePaths := []string{protoPath, goGenPath, pyGenPath}
+
+	graph, err := depgraph.BuildDependencyGraph(filePaths, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+	adj := mustAdjacency(t, graph)
+	assert.Empty(t, adj[goGenPath], "generated-from edges are off by default")
+	assert.Empty(t, adj[pyGenPath], "generated-from edges are off by default")
+
+	built, err := depgraph.BuildDependencyGraphWithOptions(filePaths, vcs.FilesystemContentReader(), depgraph.BuildOptions{LinkGeneratedProtoFiles: true})
+	require.NoError(t, err)
+
+	adj = mustAdjacency(t, built.Graph)
+	assert.Equal(t, []string{protoPath}, adj[goGenPath])
+	assert.Equal(t, []string{protoPath}, adj[pyGenPath])
+
+	edge, err := built.Graph.Edge(goGenPath, protoPath)
+	require.NoError(t, err)
+	assert.Equal(t, "generated-from", edge.Properties.Attributes["relation"])
+	assert.Equal(t, depgraph.RelationIndex{
+		{From: goGenPath, To: protoPath}: depgraph.RelationGeneratedFrom,
+		{From: pyGenPath, To: protoPath}: depgraph.RelationGeneratedFrom,
+	}, built.Relations)
+}
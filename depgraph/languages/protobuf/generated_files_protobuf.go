@@ -0,0 +1,111 @@
+package protobuf
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	graphlib "github.com/dominikbraun/graph"
+)
+
+// generatedFileSuffixes maps protoc/plugin output suffixes back to the source .proto stem.
+// Longer suffixes come first so that e.g. "_grpc.pb.go" wins over ".pb.go".
+var generatedFileSuffixes = []string{
+	"_pb2_grpc.py",
+	"_grpc_pb.d.ts",
+	"_grpc.pb.go",
+	"_grpc_pb.js",
+	"_pb2.pyi",
+	"_pb2.py",
+	"_pb.d.ts",
+	".pbgrpc.dart",
+	".pbenum.dart",
+	".pbjson.dart",
+	".grpc.swift",
+	".pb.swift",
+	".pb.dart",
+	".pb.go",
+	".pb.cc",
+	".pb.h",
+	"_pb.js",
+	"_pb.ts",
+}
+
+// GeneratedSourceStem returns the .proto file stem a generated file was produced from,
+// and whether the file name matches a known protoc output pattern.
+func GeneratedSourceStem(filePath string) (string, bool) {
+	base := filepath.Base(filePath)
+	for _, suffix := range generatedFileSuffixes {
+		if stem, ok := strings.CutSuffix(base, suffix); ok && stem != "" {
+			return stem, true
+		}
+	}
+	return "", false
+}
+
+// ResolveGeneratedSource maps a generated file to its source .proto file.
+// A .proto in the same directory is preferred; otherwise the stem must match exactly one
+// supplied .proto file. Ambiguous matches are not linked.
+func ResolveGeneratedSource(generatedFile string, protoFilesByStem map[string][]string) string {
+	stem, ok := GeneratedSourceStem(generatedFile)
+	if !ok {
+		return ""
+	}
+
+	candidates := protoFilesByStem[stem]
+	sameDir := filepath.Join(filepath.Dir(generatedFile), stem+".proto")
+	for _, candidate := range candidates {
+		if candidate == sameDir {
+			return candidate
+		}
+	}
+
+	if len(candidates) != 1 {
+		return ""
+	}
+	return candidates[0]
+}
+
+// addGeneratedFromDependencies links supplied generated files to their source .proto files.
+func addGeneratedFromDependencies(graph moduleapi.Graph, suppliedFiles map[string]bool) error {
+	protoFilesByStem := make(map[string][]string)
+	var generatedFiles []string
+	for file, ok := range suppliedFiles {
+		if !ok {
+			continue
+		}
+		if filepath.Ext(file) == ".proto" {
+			stem := strings.TrimSuffix(filepath.Base(file), ".proto")
+			protoFilesByStem[stem] = append(protoFilesByStem[stem], file)
+			continue
+		}
+		if _, isGenerated := GeneratedSourceStem(file); isGenerated {
+			generatedFiles = append(generatedFiles, file)
+		}
+	}
+	if len(protoFilesByStem) == 0 || len(generatedFiles) == 0 {
+		return nil
+	}
+	sort.Strings(generatedFiles)
+
+	for _, generated := range generatedFiles {
+		source := ResolveGeneratedSource(generated, protoFilesByStem)
+		if source == "" {
+			continue
+		}
+		if _, err := graph.Vertex(generated); err != nil {
+			continue
+		}
+		if _, err := graph.Vertex(source); err != nil {
+			continue
+		}
+		err := graph.AddEdge(generated, source, graphlib.EdgeAttribute(moduleapi.EdgeRelationAttribute, moduleapi.RelationGeneratedFrom))
+		if err != nil && !errors.Is(err, graphlib.ErrEdgeAlreadyExists) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package protobuf
+
+import (
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+type Module struct{}
+
+func (Module) Name() string {
+	return "Protocol Buffers"
+}
+
+func (Module) Extensions() []string {
+	return []string{".proto"}
+}
+
+func (Module) Maturity() moduleapi.MaturityLevel {
+	return moduleapi.MaturityUntested
+}
+
+func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
+	return resolver{ctx: ctx, contentReader: contentReader}
+}
+
+func (Module) IsTestFile(filePath string, _ vcs.ContentReader) bool {
+	return IsTestFile(filePath)
+}
+
+type resolver struct {
+	ctx           *moduleapi.Context
+	contentReader vcs.ContentReader
+}
+
+func (r resolver) ResolveProjectImports(absPath, filePath, _ string) ([]string, error) {
+	return ResolveProtoProjectImports(absPath, filePath, r.ctx.SuppliedFiles, r.ctx.Options.ProtoRoots, r.contentReader)
+}
+
+func (r resolver) FinalizeGraph(graph moduleapi.Graph) error {
+	if !r.ctx.Options.LinkGeneratedProtoFiles {
+		return nil
+	}
+	return addGeneratedFromDependencies(graph, r.ctx.SuppliedFiles)
+}
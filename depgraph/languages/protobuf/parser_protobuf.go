@@ -0,0 +1,98 @@
+package protobuf
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/protobuf"
+)
+
+// ImportKind distinguishes plain imports from public and weak imports.
+type ImportKind int
+
+const (
+	ImportDefault ImportKind = iota
+	ImportPublic
+	ImportWeak
+)
+
+// ProtoImport represents an import statement in a .proto file.
+type ProtoImport struct {
+	Path string
+	Kind ImportKind
+}
+
+// ProtoImports parses a .proto file and returns its imports.
+func ProtoImports(filePath string) ([]ProtoImport, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return ParseProtoImports(sourceCode)
+}
+
+// ParseProtoImports parses Protocol Buffers source code and extracts import statements.
+func ParseProtoImports(sourceCode []byte) ([]ProtoImport, error) {
+	lang := protobuf.GetLanguage()
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(lang)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Protocol Buffers code: %w", err)
+	}
+	defer tree.Close()
+
+	return extractImports(tree.RootNode(), sourceCode), nil
+}
+
+func extractImports(rootNode *sitter.Node, sourceCode []byte) []ProtoImport {
+	var imports []ProtoImport
+
+	for i := 0; i < int(rootNode.NamedChildCount()); i++ {
+		child := rootNode.NamedChild(i)
+		if child == nil || child.Type() != "import" {
+			continue
+		}
+		if imp := extractImportFromNode(child, sourceCode); imp.Path != "" {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
+
+func extractImportFromNode(node *sitter.Node, sourceCode []byte) ProtoImport {
+	pathNode := node.ChildByFieldName("path")
+	if pathNode == nil {
+		return ProtoImport{}
+	}
+
+	kind := ImportDefault
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child == nil {
+			continue
+		}
+		switch child.Type() {
+		case "public":
+			kind = ImportPublic
+		case "weak":
+			kind = ImportWeak
+		}
+	}
+
+	return ProtoImport{
+		Path: cleanStringLiteral(pathNode.Content(sourceCode)),
+		Kind: kind,
+	}
+}
+
+func cleanStringLiteral(raw string) string {
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(raw), "\"'"))
+}
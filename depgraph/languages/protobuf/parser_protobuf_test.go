@@ -0,0 +1,147 @@
+package protobuf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProtoImports(t *testing.T) {
+	source := `syntax = "proto3";
+
+package payments.v1;
+
+import "google/protobuf/timestamp.proto";
+import public "payments/v1/money.proto";
+import weak 'legacy/options.proto';
+
+message Charge {
+  Money amount = 1;
+}
+`
+
+	imports, err := ParseProtoImports([]byte(source))
+
+	require.NoError(t, err)
+	require.Len(t, imports, 3)
+	assert.Equal(t, ProtoImport{Path: "google/protobuf/timestamp.proto", Kind: ImportDefault}, imports[0])
+	assert.Equal(t, ProtoImport{Path: "payments/v1/money.proto", Kind: ImportPublic}, imports[1])
+	assert.Equal(t, ProtoImport{Path: "legacy/options.proto", Kind: ImportWeak}, imports[2])
+}
+
+func TestParseProtoImports_NoImports(t *testing.T) {
+	imports, err := ParseProtoImports([]byte(`syntax = "proto3";
+service Health {
+  rpc Check(Ping) returns (Pong);
+}
+`))
+
+	require.NoError(t, err)
+	assert.Empty(t, imports)
+}
+
+func TestProtoImports_ValidFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	tmpFile := filepath.Join(tmpDir, "service.proto")
+	require.NoError(t, os.WriteFile(tmpFile, []byte("syntax = \"proto3\";\nimport \"common/types.proto\";\n"), 0o644))
+
+	imports, err := ProtoImports(tmpFile)
+
+	require.NoError(t, err)
+	require.Len(t, imports, 1)
+	assert.Equal(t, "common/types.proto", imports[0].Path)
+}
+
+func TestParseBufRoots(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name: "buf.work.yaml directories",
+			content: `version: v1
+directories:
+  - proto
+  - "vendor/proto" # third party
+`,
+			want: []string{"proto", "vendor/proto"},
+		},
+		{
+			name: "buf.yaml v1 build roots",
+			content: `version: v1
+build:
+  roots:
+    - api
+    - third_party
+lint:
+  use:
+    - DEFAULT
+`,
+			want: []string{"api", "third_party"},
+		},
+		{
+			name: "buf.yaml v2 module paths",
+			content: `version: v2
+modules:
+  - path: proto
+    name: buf.build/acme/payments
+  - path: vendor/googleapis
+breaking:
+  use:
+    - FILE
+`,
+			want: []string{"proto", "vendor/googleapis"},
+		},
+		{
+			name:    "no roots",
+			content: "version: v1\nname: buf.build/acme/payments\n",
+			want:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ParseBufRoots([]byte(tc.content)))
+		})
+	}
+}
+
+func TestGeneratedSourceStem(t *testing.T) {
+	tests := []struct {
+		filePath string
+		wantStem string
+		wantOK   bool
+	}{
+		{filePath: "/repo/gen/payments.pb.go", wantStem: "payments", wantOK: true},
+		{filePath: "/repo/gen/payments_grpc.pb.go", wantStem: "payments", wantOK: true},
+		{filePath: "/repo/gen/payments_pb2.py", wantStem: "payments", wantOK: true},
+		{filePath: "/repo/gen/payments_pb2_grpc.py", wantStem: "payments", wantOK: true},
+		{filePath: "/repo/gen/payments_pb.d.ts", wantStem: "payments", wantOK: true},
+		{filePath: "/repo/cmd/main.go", wantStem: "", wantOK: false},
+		{filePath: "/repo/gen/.pb.go", wantStem: "", wantOK: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(filepath.Base(tc.filePath), func(t *testing.T) {
+			stem, ok := GeneratedSourceStem(tc.filePath)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.wantStem, stem)
+		})
+	}
+}
+
+func TestResolveGeneratedSource(t *testing.T) {
+	protoFilesByStem := map[string][]string{
+		"payments": {"/repo/proto/payments.proto"},
+		"common":   {"/repo/proto/a/common.proto", "/repo/proto/b/common.proto"},
+	}
+
+	assert.Equal(t, "/repo/proto/payments.proto", ResolveGeneratedSource("/repo/gen/payments.pb.go", protoFilesByStem))
+	assert.Equal(t, "/repo/proto/b/common.proto", ResolveGeneratedSource("/repo/proto/b/common_pb2.py", protoFilesByStem))
+	assert.Empty(t, ResolveGeneratedSource("/repo/gen/common.pb.go", protoFilesByStem), "ambiguous stems are not linked")
+	assert.Empty(t, ResolveGeneratedSource("/repo/gen/unknown.pb.go", protoFilesByStem))
+}
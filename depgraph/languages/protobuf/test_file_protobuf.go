@@ -0,0 +1,26 @@
+package protobuf
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsTestFile reports whether the given .proto path is a test definition or test fixture.
+func IsTestFile(filePath string) bool {
+	fileName := filepath.Base(filePath)
+	ext := filepath.Ext(fileName)
+	if ext != ".proto" {
+		return false
+	}
+
+	base := strings.TrimSuffix(fileName, ext)
+	if strings.HasSuffix(base, "_test") || strings.HasPrefix(base, "test_") {
+		return true
+	}
+
+	path := filepath.ToSlash(filePath)
+	return strings.Contains(path, "/test/") ||
+		strings.Contains(path, "/tests/") ||
+		strings.Contains(path, "/testdata/") ||
+		strings.Contains(path, "/fixtures/")
+}
@@ -0,0 +1,15 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("/repo/proto/payments_test.proto"))
+	assert.True(t, IsTestFile("/repo/internal/testdata/fixture.proto"))
+	assert.True(t, IsTestFile("/repo/test/fixtures/sample.proto"))
+	assert.False(t, IsTestFile("/repo/proto/payments.proto"))
+	assert.False(t, IsTestFile("/repo/test/payments_test.go"))
+}
@@ -3,6 +3,7 @@ package python
 import (
 	"fmt"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/LegacyCodeHQ/clarity/vcs"
 )
 
@@ -33,3 +34,32 @@ func ResolvePythonProjectImports(
 
 	return projectImports, nil
 }
+
+// LocatePythonProjectImports reports the import statement behind each project import.
+func LocatePythonProjectImports(
+	absPath string,
+	filePath string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]moduleapi.ImportReference, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	sites, parseErr := ParsePythonImportSites(content)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, parseErr)
+	}
+
+	var references []moduleapi.ImportReference
+	for _, site := range sites {
+		targets := ResolvePythonImportPath(absPath, site.Specifier, suppliedFiles)
+		targets = append(targets, ResolvePythonAbsoluteImportPath(site.Specifier, suppliedFiles)...)
+		for _, target := range targets {
+			references = append(references, moduleapi.ImportReference{ImportSite: site, Target: target})
+		}
+	}
+
+	return references, nil
+}
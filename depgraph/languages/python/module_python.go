@@ -19,6 +19,10 @@ func (Module) Maturity() moduleapi.MaturityLevel {
 	return moduleapi.MaturityBasicTests
 }
 
+func (Module) LocatesImports() bool {
+	return true
+}
+
 func (Module) NewResolver(ctx *moduleapi.Context, contentReader vcs.ContentReader) moduleapi.Resolver {
 	return resolver{ctx: ctx, contentReader: contentReader}
 }
@@ -36,6 +40,10 @@ func (r resolver) ResolveProjectImports(absPath, filePath, ext string) ([]string
 	return ResolvePythonProjectImports(absPath, filePath, ext, r.ctx.SuppliedFiles, r.contentReader)
 }
 
+func (r resolver) LocateProjectImports(absPath, filePath, _ string) ([]moduleapi.ImportReference, error) {
+	return LocatePythonProjectImports(absPath, filePath, r.ctx.SuppliedFiles, r.contentReader)
+}
+
 func (resolver) FinalizeGraph(_ moduleapi.Graph) error {
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/python"
 )
@@ -81,6 +82,53 @@ func ParsePythonImports(sourceCode []byte) ([]PythonImport, error) {
 	return extractImportsFromTree(tree.RootNode(), sourceCode), nil
 }
 
+// ParsePythonImportSites parses Python source code and returns the position and module of
+// every import statement.
+func ParsePythonImportSites(sourceCode []byte) ([]moduleapi.ImportSite, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(python.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Python code: %w", err)
+	}
+	defer tree.Close()
+
+	var sites []moduleapi.ImportSite
+	var walk func(*sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "import_statement":
+			for _, module := range extractImportStatementModules(n, sourceCode) {
+				sites = append(sites, importSiteAt(n, module))
+			}
+		case "import_from_statement", "future_import_statement":
+			if module := extractImportFromModule(n, sourceCode); module != "" {
+				sites = append(sites, importSiteAt(n, module))
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return sites, nil
+}
+
+// importSiteAt records the 1-based position of an import statement.
+func importSiteAt(node *sitter.Node, specifier string) moduleapi.ImportSite {
+	start := node.StartPoint()
+	return moduleapi.ImportSite{
+		Specifier: specifier,
+		Line:      int(start.Row) + 1,
+		Column:    int(start.Column) + 1,
+	}
+}
+
 // extractImportsFromTree walks the AST and extracts imports.
 func extractImportsFromTree(rootNode *sitter.Node, sourceCode []byte) []PythonImport {
 	var imports []PythonImport
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -118,3 +119,21 @@ func extractPaths(imports []PythonImport) []string {
 	}
 	return paths
 }
+
+func TestParsePythonImportSites_RecordsStatementPositions(t *testing.T) {
+	source := `import os, pkg.module
+from .sibling import thing
+
+def f():
+    from ..parent import other
+`
+	sites, err := ParsePythonImportSites([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []moduleapi.ImportSite{
+		{Specifier: "os", Line: 1, Column: 1},
+		{Specifier: "pkg.module", Line: 1, Column: 1},
+		{Specifier: ".sibling", Line: 2, Column: 1},
+		{Specifier: "..parent", Line: 5, Column: 5},
+	}, sites)
+}
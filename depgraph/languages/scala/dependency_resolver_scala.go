@@ -0,0 +1,69 @@
+package scala
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// ResolveScalaProjectImports resolves Scala imports and same-package references
+// against the shared JVM package index.
+func ResolveScalaProjectImports(
+	absPath string,
+	filePath string,
+	index *moduleapi.JVMIndex,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	imports, err := ParseScalaImports(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, err)
+	}
+
+	typeReferences := ExtractTypeIdentifiers(content)
+	referencedTypes := make(map[string]bool, len(typeReferences))
+	for _, ref := range typeReferences {
+		referencedTypes[ref] = true
+	}
+
+	excludedNames := make(map[string]bool)
+	for _, name := range ParseTopLevelTypeNames(content) {
+		excludedNames[name] = true
+	}
+
+	seen := make(map[string]bool)
+	var projectImports []string
+	addFiles := func(files []string) {
+		for _, file := range files {
+			if file == absPath || !suppliedFiles[file] || seen[file] {
+				continue
+			}
+			seen[file] = true
+			projectImports = append(projectImports, file)
+		}
+	}
+
+	for _, imp := range imports {
+		addFiles(index.ResolveImport(imp.Path, imp.IsWildcard, referencedTypes))
+		if !imp.IsWildcard {
+			excludedNames[lastSegment(imp.Path)] = true
+		}
+	}
+	addFiles(index.ResolveSamePackageReferences(absPath, typeReferences, excludedNames))
+
+	return projectImports, nil
+}
+
+func lastSegment(path string) string {
+	if idx := strings.LastIndex(path, "."); idx >= 0 {
+		return path[idx+1:]
+	}
+	return path
+}
@@ -0,0 +1,87 @@
+package scala_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_ScalaResolvesImportsAndSamePackageReferences(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	moneyPath := filepath.Join(tmpDir, "src", "main", "scala", "com", "acme", "model", "Money.scala")
+	ledgerPath := filepath.Join(tmpDir, "src", "main", "scala", "com", "acme", "billing", "Ledger.scala")
+	invoicePath := filepath.Join(tmpDir, "src", "main", "scala", "com", "acme", "billing", "Invoice.scala")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(moneyPath), 0o755))
+	require.NoError(t, os.WriteFile(moneyPath, []byte("package com.acme.model\n\ncase class Money(cents: Long)\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(ledgerPath), 0o755))
+	require.NoError(t, os.WriteFile(ledgerPath, []byte("package com.acme.billing\n\nclass Ledger\n"), 0o644))
+	require.NoError(t, os.WriteFile(invoicePath, []byte(`package com.acme.billing
+
+import com.acme.model.Money
+
+class Invoice(ledger: Ledger) {
+  def total: Money = Money(0)
+}
+`), 0o644))
+
+	files := []string{moneyPath, ledgerPath, invoicePath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.ElementsMatch(t, []string{moneyPath, ledgerPath}, adj[invoicePath])
+	assert.Empty(t, adj[moneyPath])
+}
+
+func TestBuildDependencyGraph_JVMEdgesCrossKotlinJavaAndScala(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	scalaPath := filepath.Join(tmpDir, "pricing", "src", "main", "scala", "com", "acme", "pricing", "PriceEngine.scala")
+	javaPath := filepath.Join(tmpDir, "backend", "src", "main", "java", "com", "acme", "orders", "OrderService.java")
+	kotlinPath := filepath.Join(tmpDir, "app", "src", "main", "kotlin", "com", "acme", "app", "CheckoutViewModel.kt")
+	javaSamePackagePath := filepath.Join(tmpDir, "pricing", "src", "main", "java", "com", "acme", "pricing", "PriceRules.java")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(scalaPath), 0o755))
+	require.NoError(t, os.WriteFile(scalaPath, []byte("package com.acme.pricing\n\nclass PriceEngine(rules: PriceRules)\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(javaSamePackagePath), 0o755))
+	require.NoError(t, os.WriteFile(javaSamePackagePath, []byte("package com.acme.pricing;\n\npublic class PriceRules {}\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(javaPath), 0o755))
+	require.NoError(t, os.WriteFile(javaPath, []byte(`package com.acme.orders;
+
+import com.acme.pricing.PriceEngine;
+
+public class OrderService {
+    private PriceEngine engine;
+}
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(kotlinPath), 0o755))
+	require.NoError(t, os.WriteFile(kotlinPath, []byte(`package com.acme.app
+
+import com.acme.orders.OrderService
+
+class CheckoutViewModel(private val orders: OrderService)
+`), 0o644))
+
+	files := []string{scalaPath, javaSamePackagePath, javaPath, kotlinPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.Equal(t, []string{javaPath}, adj[kotlinPath])
+	assert.Equal(t, []string{scalaPath}, adj[javaPath])
+	assert.Equal(t, []string{javaSamePackagePath}, adj[scalaPath])
+}
@@ -0,0 +1,227 @@
+package scala
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/jvm"
+	sitter "github.com/smacker/go-tree-sitter"
+	tsscala "github.com/smacker/go-tree-sitter/scala"
+)
+
+// ScalaImport represents a single imported name or wildcard in a Scala import clause.
+// `import a.b.{C, D => E}` yields one ScalaImport per selector.
+type ScalaImport struct {
+	Path       string
+	IsWildcard bool
+}
+
+// ScalaImports parses a Scala file and returns its imports.
+func ScalaImports(filePath string) ([]ScalaImport, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return ParseScalaImports(sourceCode)
+}
+
+// ParseScalaImports parses Scala source code and extracts import clauses.
+func ParseScalaImports(sourceCode []byte) ([]ScalaImport, error) {
+	tree, err := parseScala(sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Scala code: %w", err)
+	}
+	defer tree.Close()
+
+	var imports []ScalaImport
+	for _, node := range findNodesOfType(tree.RootNode(), "import_declaration") {
+		imports = append(imports, importsFromDeclaration(node, sourceCode)...)
+	}
+
+	return imports, nil
+}
+
+func importsFromDeclaration(node *sitter.Node, sourceCode []byte) []ScalaImport {
+	var pathParts []string
+	var imports []ScalaImport
+	selectorsSeen := false
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if node.FieldNameForChild(i) == "path" {
+			if child.Type() == "identifier" {
+				pathParts = append(pathParts, child.Content(sourceCode))
+			}
+			continue
+		}
+
+		prefix := strings.Join(pathParts, ".")
+		switch child.Type() {
+		case "namespace_wildcard":
+			selectorsSeen = true
+			imports = append(imports, ScalaImport{Path: prefix, IsWildcard: true})
+		case "namespace_selectors":
+			selectorsSeen = true
+			imports = append(imports, importsFromSelectors(prefix, child, sourceCode)...)
+		}
+	}
+
+	if !selectorsSeen && len(pathParts) > 0 {
+		imports = append(imports, ScalaImport{Path: strings.Join(pathParts, ".")})
+	}
+
+	return imports
+}
+
+func importsFromSelectors(prefix string, selectors *sitter.Node, sourceCode []byte) []ScalaImport {
+	var imports []ScalaImport
+	for i := 0; i < int(selectors.NamedChildCount()); i++ {
+		selector := selectors.NamedChild(i)
+		switch selector.Type() {
+		case "identifier":
+			imports = append(imports, ScalaImport{Path: prefix + "." + selector.Content(sourceCode)})
+		case "arrow_renamed_identifier", "as_renamed_identifier":
+			name := selector.ChildByFieldName("name")
+			alias := selector.ChildByFieldName("alias")
+			// `D => _` hides a name rather than importing it.
+			if name == nil || (alias != nil && alias.Content(sourceCode) == "_") {
+				continue
+			}
+			imports = append(imports, ScalaImport{Path: prefix + "." + name.Content(sourceCode)})
+		case "namespace_wildcard":
+			imports = append(imports, ScalaImport{Path: prefix, IsWildcard: true})
+		}
+	}
+	return imports
+}
+
+// ParsePackageDeclaration returns the package of a Scala file. Chained clauses such as
+// `package com.acme` followed by `package billing` are joined into `com.acme.billing`.
+func ParsePackageDeclaration(sourceCode []byte) string {
+	tree, err := parseScala(sourceCode)
+	if err != nil {
+		return ""
+	}
+	defer tree.Close()
+
+	var parts []string
+	node := tree.RootNode()
+	for node != nil {
+		var next *sitter.Node
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			if child.Type() != "package_clause" {
+				continue
+			}
+			if name := child.ChildByFieldName("name"); name != nil {
+				parts = append(parts, name.Content(sourceCode))
+			}
+			if body := child.ChildByFieldName("body"); body != nil {
+				next = body
+				break
+			}
+		}
+		node = next
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// ParseTopLevelTypeNames returns the classes, objects, traits, enums and type aliases
+// declared at the top level of a Scala file, including inside package blocks.
+func ParseTopLevelTypeNames(sourceCode []byte) []string {
+	tree, err := parseScala(sourceCode)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	seen := make(map[string]bool)
+	var names []string
+	var walk func(*sitter.Node)
+	walk = func(node *sitter.Node) {
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			child := node.NamedChild(i)
+			switch child.Type() {
+			case "class_definition", "object_definition", "trait_definition", "enum_definition", "type_definition":
+				if name := child.ChildByFieldName("name"); name != nil {
+					typeName := name.Content(sourceCode)
+					if !seen[typeName] {
+						seen[typeName] = true
+						names = append(names, typeName)
+					}
+				}
+			case "package_clause":
+				if body := child.ChildByFieldName("body"); body != nil {
+					walk(body)
+				}
+			}
+		}
+	}
+	walk(tree.RootNode())
+
+	return names
+}
+
+// ExtractTypeIdentifiers returns type names referenced in a Scala file, including types
+// used as values such as `Money.zero` or `Helper(1)`. Import and package clauses are skipped.
+func ExtractTypeIdentifiers(sourceCode []byte) []string {
+	tree, err := parseScala(sourceCode)
+	if err != nil {
+		return nil
+	}
+	defer tree.Close()
+
+	seen := make(map[string]bool)
+	var identifiers []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			identifiers = append(identifiers, name)
+		}
+	}
+
+	var walk func(*sitter.Node)
+	walk = func(node *sitter.Node) {
+		switch node.Type() {
+		case "import_declaration", "package_identifier":
+			return
+		case "type_identifier":
+			add(node.Content(sourceCode))
+		case "identifier":
+			if name := node.Content(sourceCode); jvm.IsUpperCamelIdentifier(name) {
+				add(name)
+			}
+		}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			walk(node.NamedChild(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return identifiers
+}
+
+func parseScala(sourceCode []byte) (*sitter.Tree, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(tsscala.GetLanguage())
+	return parser.ParseCtx(context.Background(), nil, sourceCode)
+}
+
+func findNodesOfType(node *sitter.Node, nodeType string) []*sitter.Node {
+	if node == nil {
+		return nil
+	}
+	if node.Type() == nodeType {
+		return []*sitter.Node{node}
+	}
+
+	var nodes []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		nodes = append(nodes, findNodesOfType(node.NamedChild(i), nodeType)...)
+	}
+	return nodes
+}
@@ -0,0 +1,68 @@
+package scala
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const invoiceSource = `package com.acme
+package billing
+
+import com.acme.model.{User, Account => Acct, Secret => _}
+import com.acme.util._
+import com.acme.svc.*
+import scala.collection.mutable
+
+case class Invoice(user: User) extends Base with Auditable {
+  def total: Money = Money.zero
+  val ledger = new Ledger()
+  val helper = Helper(1)
+}
+object Invoice
+trait Auditable
+`
+
+func TestParseScalaImports(t *testing.T) {
+	imports, err := ParseScalaImports([]byte(invoiceSource))
+
+	require.NoError(t, err)
+	assert.Equal(t, []ScalaImport{
+		{Path: "com.acme.model.User"},
+		{Path: "com.acme.model.Account"},
+		{Path: "com.acme.util", IsWildcard: true},
+		{Path: "com.acme.svc", IsWildcard: true},
+		{Path: "scala.collection.mutable"},
+	}, imports)
+}
+
+func TestParsePackageDeclaration_JoinsChainedClauses(t *testing.T) {
+	assert.Equal(t, "com.acme.billing", ParsePackageDeclaration([]byte(invoiceSource)))
+	assert.Equal(t, "com.acme", ParsePackageDeclaration([]byte("package com.acme {\n  class A\n}\n")))
+	assert.Empty(t, ParsePackageDeclaration([]byte("object Script\n")))
+}
+
+func TestParseTopLevelTypeNames(t *testing.T) {
+	assert.Equal(t, []string{"Invoice", "Auditable"}, ParseTopLevelTypeNames([]byte(invoiceSource)))
+	assert.Equal(t, []string{"A"}, ParseTopLevelTypeNames([]byte("package com.acme {\n  class A\n}\n")))
+}
+
+func TestExtractTypeIdentifiers(t *testing.T) {
+	identifiers := ExtractTypeIdentifiers([]byte(invoiceSource))
+
+	assert.Subset(t, identifiers, []string{"User", "Base", "Auditable", "Money", "Ledger", "Helper"})
+	assert.NotContains(t, identifiers, "Acct")
+}
+
+func TestScalaImports_ValidFile(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "App.scala")
+	require.NoError(t, os.WriteFile(tmpFile, []byte("import com.acme.App\n"), 0o644))
+
+	imports, err := ScalaImports(tmpFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, []ScalaImport{{Path: "com.acme.App"}}, imports)
+}
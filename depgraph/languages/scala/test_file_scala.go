@@ -0,0 +1,25 @@
+package scala
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// IsTestFile reports whether the given Scala file path is a test file.
+func IsTestFile(filePath string) bool {
+	base := filepath.Base(filePath)
+	ext := filepath.Ext(base)
+	if ext != ".scala" && ext != ".sc" {
+		return false
+	}
+
+	name := strings.TrimSuffix(base, ext)
+	for _, suffix := range []string{"Test", "Tests", "Spec", "Suite"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	slashed := filepath.ToSlash(filePath)
+	return strings.Contains(slashed, "/src/test/") || strings.Contains(slashed, "/test/")
+}
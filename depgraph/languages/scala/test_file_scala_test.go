@@ -0,0 +1,14 @@
+package scala
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("core/src/test/scala/com/acme/InvoiceSpec.scala"))
+	assert.True(t, IsTestFile("core/src/main/scala/com/acme/LedgerSuite.scala"))
+	assert.False(t, IsTestFile("core/src/main/scala/com/acme/Invoice.scala"))
+	assert.False(t, IsTestFile("core/src/main/java/com/acme/InvoiceTest.java"))
+}
@@ -0,0 +1,77 @@
+package sfc
+
+import (
+	"path/filepath"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/javascript"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/typescript"
+)
+
+// InternalImportPaths parses each script block with the JavaScript or TypeScript parser,
+// according to its lang attribute, and returns the paths of project-internal imports.
+// Blocks that fail to parse are skipped.
+func InternalImportPaths(blocks []ScriptBlock) []string {
+	var paths []string
+	for _, block := range blocks {
+		if block.IsTypeScript() {
+			imports, err := typescript.ParseTypeScriptImports(block.Content, block.IsJSX())
+			if err != nil {
+				continue
+			}
+			for _, imp := range imports {
+				if internalImp, ok := imp.(typescript.InternalImport); ok {
+					paths = append(paths, internalImp.Path())
+				}
+			}
+			continue
+		}
+
+		imports, err := javascript.ParseJavaScriptImports(block.Content, block.IsJSX())
+		if err != nil {
+			continue
+		}
+		for _, imp := range imports {
+			if internalImp, ok := imp.(javascript.InternalImport); ok {
+				paths = append(paths, internalImp.Path())
+			}
+		}
+	}
+	return paths
+}
+
+// ResolveComponentImportPath resolves an import from a component's script to possible file paths.
+// Script modules resolve like TypeScript imports (.ts, .tsx, .js, .jsx and index files, with the
+// "@/" alias), and component files resolve by trying each of componentExts.
+func ResolveComponentImportPath(sourceFile, importPath string, componentExts []string, suppliedFiles map[string]bool) []string {
+	seen := make(map[string]bool)
+	var resolved []string
+	add := func(candidate string) {
+		if suppliedFiles[candidate] && !seen[candidate] {
+			seen[candidate] = true
+			resolved = append(resolved, candidate)
+		}
+	}
+
+	for _, candidate := range typescript.ResolveTypeScriptImportPath(sourceFile, importPath, suppliedFiles) {
+		add(candidate)
+	}
+	for _, candidate := range javascript.ResolveJavaScriptImportPath(sourceFile, importPath, suppliedFiles) {
+		add(candidate)
+	}
+
+	basePath, ok := typescript.ResolveTypeScriptBasePath(sourceFile, importPath)
+	if !ok {
+		return resolved
+	}
+
+	for _, ext := range componentExts {
+		if filepath.Ext(importPath) == ext {
+			add(basePath)
+			continue
+		}
+		add(basePath + ext)
+		add(filepath.Join(basePath, "index"+ext))
+	}
+
+	return resolved
+}
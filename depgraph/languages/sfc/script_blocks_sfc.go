@@ -0,0 +1,153 @@
+package sfc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/html"
+)
+
+// ScriptBlock is the content of one <script> element (or frontmatter fence) in a
+// single-file component such as a Svelte, Vue or Astro file.
+type ScriptBlock struct {
+	Content []byte
+	// Lang is the value of the lang attribute, for example "ts". Empty means JavaScript.
+	Lang string
+	// Setup is true for Vue's <script setup> blocks.
+	Setup bool
+}
+
+// IsTypeScript reports whether the block should be parsed as TypeScript.
+func (b ScriptBlock) IsTypeScript() bool {
+	switch b.Lang {
+	case "ts", "tsx", "typescript":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsJSX reports whether the block contains JSX or TSX markup.
+func (b ScriptBlock) IsJSX() bool {
+	return b.Lang == "jsx" || b.Lang == "tsx"
+}
+
+// ParseScriptBlocks parses HTML-like component source with the HTML grammar and returns
+// its <script> blocks. It suits formats without a dedicated grammar, such as Vue.
+func ParseScriptBlocks(sourceCode []byte) ([]ScriptBlock, error) {
+	parser := sitter.NewParser()
+	parser.SetLanguage(html.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component markup: %w", err)
+	}
+	defer tree.Close()
+
+	return ExtractScriptBlocks(tree.RootNode(), sourceCode), nil
+}
+
+// ExtractScriptBlocks walks an HTML-like AST and returns each <script> element with
+// its lang and setup attributes. Both the HTML and Svelte grammars produce the
+// script_element shape this expects.
+func ExtractScriptBlocks(rootNode *sitter.Node, sourceCode []byte) []ScriptBlock {
+	var blocks []ScriptBlock
+
+	var walk func(*sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+
+		if n.Type() == "script_element" {
+			if block, ok := scriptBlockFromElement(n, sourceCode); ok {
+				blocks = append(blocks, block)
+			}
+			return
+		}
+
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+
+	walk(rootNode)
+	return blocks
+}
+
+func scriptBlockFromElement(scriptNode *sitter.Node, sourceCode []byte) (ScriptBlock, bool) {
+	var block ScriptBlock
+	found := false
+
+	for i := 0; i < int(scriptNode.ChildCount()); i++ {
+		child := scriptNode.Child(i)
+		switch child.Type() {
+		case "start_tag":
+			block.Lang, block.Setup = scriptAttributes(child, sourceCode)
+		case "raw_text":
+			block.Content = []byte(child.Content(sourceCode))
+			found = true
+		}
+	}
+
+	return block, found
+}
+
+func scriptAttributes(startTag *sitter.Node, sourceCode []byte) (string, bool) {
+	lang := ""
+	setup := false
+
+	for i := 0; i < int(startTag.NamedChildCount()); i++ {
+		attribute := startTag.NamedChild(i)
+		if attribute.Type() != "attribute" {
+			continue
+		}
+
+		name := ""
+		value := ""
+		for j := 0; j < int(attribute.NamedChildCount()); j++ {
+			part := attribute.NamedChild(j)
+			switch part.Type() {
+			case "attribute_name":
+				name = strings.ToLower(part.Content(sourceCode))
+			case "attribute_value":
+				value = part.Content(sourceCode)
+			case "quoted_attribute_value":
+				value = strings.Trim(part.Content(sourceCode), `"'`)
+			}
+		}
+
+		switch name {
+		case "lang":
+			lang = strings.ToLower(value)
+		case "setup":
+			setup = true
+		}
+	}
+
+	return lang, setup
+}
+
+var frontmatterFence = []byte("---")
+
+// SplitFrontmatter separates a leading `---` fenced frontmatter script, as used by Astro,
+// from the markup that follows it. ok is false when the source has no frontmatter.
+func SplitFrontmatter(sourceCode []byte) (frontmatter []byte, body []byte, ok bool) {
+	trimmed := bytes.TrimLeft(sourceCode, " \t\r\n")
+	if !bytes.HasPrefix(trimmed, frontmatterFence) {
+		return nil, sourceCode, false
+	}
+
+	rest := trimmed[len(frontmatterFence):]
+	end := bytes.Index(rest, append([]byte("\n"), frontmatterFence...))
+	if end < 0 {
+		return nil, sourceCode, false
+	}
+
+	frontmatter = rest[:end+1]
+	body = rest[end+1+len(frontmatterFence):]
+	return frontmatter, body, true
+}
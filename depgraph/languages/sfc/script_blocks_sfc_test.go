@@ -0,0 +1,85 @@
+package sfc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScriptBlocks(t *testing.T) {
+	source := `<template>
+  <div :class="x" @click="go" v-if="a < b"><Child /></div>
+</template>
+<script setup lang="ts">
+import Child from './Child.vue'
+</script>
+<script lang='tsx'>
+export default {}
+</script>
+<script>
+import util from './util'
+</script>
+`
+
+	blocks, err := ParseScriptBlocks([]byte(source))
+
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+	assert.Equal(t, "ts", blocks[0].Lang)
+	assert.True(t, blocks[0].Setup)
+	assert.True(t, blocks[0].IsTypeScript())
+	assert.Contains(t, string(blocks[0].Content), "import Child from './Child.vue'")
+	assert.True(t, blocks[1].IsTypeScript())
+	assert.True(t, blocks[1].IsJSX())
+	assert.False(t, blocks[2].IsTypeScript())
+	assert.False(t, blocks[2].Setup)
+}
+
+func TestSplitFrontmatter(t *testing.T) {
+	source := `---
+import Layout from '../layouts/Layout.astro'
+const title = "---"
+---
+<Layout title={title} />
+`
+
+	frontmatter, body, ok := SplitFrontmatter([]byte(source))
+
+	require.True(t, ok)
+	assert.Equal(t, "\nimport Layout from '../layouts/Layout.astro'\nconst title = \"---\"\n", string(frontmatter))
+	assert.Equal(t, "\n<Layout title={title} />\n", string(body))
+
+	_, body, ok = SplitFrontmatter([]byte("<p>No frontmatter</p>"))
+	assert.False(t, ok)
+	assert.Equal(t, "<p>No frontmatter</p>", string(body))
+}
+
+func TestInternalImportPaths_RoutesByLang(t *testing.T) {
+	blocks := []ScriptBlock{
+		{Content: []byte("import type { User } from '@/types/user'\nimport { ref } from 'vue'\n"), Lang: "ts"},
+		{Content: []byte("import api from './api'\nimport alias from '@/ignored-in-js'\n")},
+	}
+
+	assert.Equal(t, []string{"@/types/user", "./api"}, InternalImportPaths(blocks))
+}
+
+func TestResolveComponentImportPath(t *testing.T) {
+	suppliedFiles := map[string]bool{
+		"/project/src/components/Child.vue":       true,
+		"/project/src/components/Modal/index.vue": true,
+		"/project/src/types/user.ts":              true,
+		"/project/src/api.js":                     true,
+	}
+	sourceFile := "/project/src/components/Parent.vue"
+	exts := []string{".vue"}
+
+	assert.Equal(t, []string{"/project/src/components/Child.vue"},
+		ResolveComponentImportPath(sourceFile, "./Child.vue", exts, suppliedFiles))
+	assert.Equal(t, []string{"/project/src/components/Modal/index.vue"},
+		ResolveComponentImportPath(sourceFile, "./Modal", exts, suppliedFiles))
+	assert.Equal(t, []string{"/project/src/types/user.ts"},
+		ResolveComponentImportPath(sourceFile, "@/types/user", exts, suppliedFiles))
+	assert.Equal(t, []string{"/project/src/api.js"},
+		ResolveComponentImportPath(sourceFile, "../api", exts, suppliedFiles))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/smacker/go-tree-sitter/svelte"
 
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/javascript"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/sfc"
 )
 
 // ParseSvelteImports parses a Svelte file and extracts JavaScript imports
@@ -24,11 +25,9 @@ func ParseSvelteImports(sourceCode []byte) ([]javascript.JavaScriptImport, error
 	}
 	defer tree.Close()
 
-	scriptContents := extractScriptContents(tree.RootNode(), sourceCode)
-
 	var allImports []javascript.JavaScriptImport
-	for _, script := range scriptContents {
-		imports, err := javascript.ParseJavaScriptImports(script, false)
+	for _, script := range sfc.ExtractScriptBlocks(tree.RootNode(), sourceCode) {
+		imports, err := javascript.ParseJavaScriptImports(script.Content, false)
 		if err != nil {
 			continue
 		}
@@ -37,40 +36,3 @@ func ParseSvelteImports(sourceCode []byte) ([]javascript.JavaScriptImport, error
 
 	return allImports, nil
 }
-
-// extractScriptContents walks the Svelte AST and returns the text content
-// of each <script> element.
-func extractScriptContents(rootNode *sitter.Node, sourceCode []byte) [][]byte {
-	var scripts [][]byte
-
-	var walk func(*sitter.Node)
-	walk = func(n *sitter.Node) {
-		if n == nil {
-			return
-		}
-
-		if n.Type() == "script_element" {
-			if content := extractRawText(n, sourceCode); content != nil {
-				scripts = append(scripts, content)
-			}
-		}
-
-		for i := 0; i < int(n.ChildCount()); i++ {
-			walk(n.Child(i))
-		}
-	}
-
-	walk(rootNode)
-	return scripts
-}
-
-// extractRawText finds the raw_text child of a script_element node.
-func extractRawText(scriptNode *sitter.Node, sourceCode []byte) []byte {
-	for i := 0; i < int(scriptNode.ChildCount()); i++ {
-		child := scriptNode.Child(i)
-		if child != nil && child.Type() == "raw_text" {
-			return []byte(child.Content(sourceCode))
-		}
-	}
-	return nil
-}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	sitter "github.com/smacker/go-tree-sitter"
 	"github.com/smacker/go-tree-sitter/typescript/tsx"
 	"github.com/smacker/go-tree-sitter/typescript/typescript"
@@ -149,6 +150,59 @@ func ParseTypeScriptImports(sourceCode []byte, isTSX bool) ([]TypeScriptImport,
 	return extractImportsFromTree(tree.RootNode(), sourceCode, lang)
 }
 
+// ParseTypeScriptImportSites parses TypeScript source code and returns the position and
+// specifier of every import and re-export statement.
+func ParseTypeScriptImportSites(sourceCode []byte, isTSX bool) ([]moduleapi.ImportSite, error) {
+	lang := typescript.GetLanguage()
+	if isTSX {
+		lang = tsx.GetLanguage()
+	}
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(lang)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TypeScript code: %w", err)
+	}
+	defer tree.Close()
+
+	var sites []moduleapi.ImportSite
+	var walk func(*sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if n.Type() == "import_statement" || n.Type() == "export_statement" {
+			for i := 0; i < int(n.ChildCount()); i++ {
+				child := n.Child(i)
+				if child != nil && child.Type() == "string" {
+					if importPath := cleanImportPath(child.Content(sourceCode)); importPath != "" {
+						sites = append(sites, importSiteAt(n, importPath))
+					}
+					break
+				}
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+
+	return sites, nil
+}
+
+// importSiteAt records the 1-based position of an import statement.
+func importSiteAt(node *sitter.Node, specifier string) moduleapi.ImportSite {
+	start := node.StartPoint()
+	return moduleapi.ImportSite{
+		Specifier: specifier,
+		Line:      int(start.Row) + 1,
+		Column:    int(start.Column) + 1,
+	}
+}
+
 // extractImportsFromTree walks the AST and extracts imports
 func extractImportsFromTree(rootNode *sitter.Node, sourceCode []byte, lang *sitter.Language) ([]TypeScriptImport, error) {
 	var imports []TypeScriptImport
@@ -304,7 +358,7 @@ func cleanImportPath(raw string) string {
 
 // ResolveTypeScriptImportPath resolves a TypeScript import path to possible file paths
 func ResolveTypeScriptImportPath(sourceFile, importPath string, suppliedFiles map[string]bool) []string {
-	basePath, ok := resolveTypeScriptBasePath(sourceFile, importPath)
+	basePath, ok := ResolveTypeScriptBasePath(sourceFile, importPath)
 	if !ok {
 		return nil
 	}
@@ -376,7 +430,9 @@ func sourceCandidatesForJSImport(basePath string) []string {
 	}
 }
 
-func resolveTypeScriptBasePath(sourceFile, importPath string) (string, bool) {
+// ResolveTypeScriptBasePath resolves an internal import to an absolute path without extension
+// handling, expanding the "@/" alias to the nearest enclosing src directory.
+func ResolveTypeScriptBasePath(sourceFile, importPath string) (string, bool) {
 	// Resolve common alias format "@/..." to "<repo>/src/..."
 	if strings.HasPrefix(importPath, "@/") {
 		srcRoot, ok := projectSrcRootFromSourceFile(sourceFile)
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -530,3 +531,20 @@ func assertImportType(t *testing.T, imports []TypeScriptImport, path string, exp
 	}
 	t.Errorf("Import with path %s not found", path)
 }
+
+func TestParseTypeScriptImportSites_RecordsStatementPositions(t *testing.T) {
+	source := `import { a } from './a';
+export { b } from "./b";
+
+  import type { C } from './c';
+const x = 1;
+`
+	sites, err := ParseTypeScriptImportSites([]byte(source), false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []moduleapi.ImportSite{
+		{Specifier: "./a", Line: 1, Column: 1},
+		{Specifier: "./b", Line: 2, Column: 1},
+		{Specifier: "./c", Line: 4, Column: 3},
+	}, sites)
+}
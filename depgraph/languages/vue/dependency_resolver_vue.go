@@ -0,0 +1,35 @@
+package vue
+
+import (
+	"fmt"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/sfc"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// vueComponentExtensions lists the component files a Vue script can import.
+var vueComponentExtensions = []string{".vue"}
+
+func ResolveVueProjectImports(
+	absPath string,
+	filePath string,
+	suppliedFiles map[string]bool,
+	contentReader vcs.ContentReader,
+) ([]string, error) {
+	content, err := contentReader(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", absPath, err)
+	}
+
+	importPaths, parseErr := ParseVueImports(content)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse imports in %s: %w", filePath, parseErr)
+	}
+
+	var projectImports []string
+	for _, importPath := range importPaths {
+		projectImports = append(projectImports, sfc.ResolveComponentImportPath(absPath, importPath, vueComponentExtensions, suppliedFiles)...)
+	}
+
+	return projectImports, nil
+}
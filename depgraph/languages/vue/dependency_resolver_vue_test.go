@@ -0,0 +1,54 @@
+package vue_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAdjacency(t *testing.T, g depgraph.DependencyGraph) map[string][]string {
+	t.Helper()
+	adj, err := depgraph.AdjacencyList(g)
+	require.NoError(t, err)
+	return adj
+}
+
+func TestBuildDependencyGraph_VueResolvesComponentsAndScripts(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	appPath := filepath.Join(tmpDir, "src", "App.vue")
+	cardPath := filepath.Join(tmpDir, "src", "components", "UserCard.vue")
+	typesPath := filepath.Join(tmpDir, "src", "types", "user.ts")
+	apiPath := filepath.Join(tmpDir, "src", "api.js")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(appPath), 0o755))
+	require.NoError(t, os.WriteFile(appPath, []byte(`<template><UserCard /></template>
+<script setup lang="ts">
+import UserCard from './components/UserCard.vue'
+import type { User } from '@/types/user'
+</script>
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(cardPath), 0o755))
+	require.NoError(t, os.WriteFile(cardPath, []byte(`<template><div /></template>
+<script>
+import api from '../api'
+export default {}
+</script>
+`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(typesPath), 0o755))
+	require.NoError(t, os.WriteFile(typesPath, []byte("export interface User { id: string }\n"), 0o644))
+	require.NoError(t, os.WriteFile(apiPath, []byte("export default {}\n"), 0o644))
+
+	files := []string{appPath, cardPath, typesPath, apiPath}
+	graph, err := depgraph.BuildDependencyGraph(files, vcs.FilesystemContentReader())
+	require.NoError(t, err)
+
+	adj := mustAdjacency(t, graph)
+	assert.ElementsMatch(t, []string{cardPath, typesPath}, adj[appPath])
+	assert.Equal(t, []string{apiPath}, adj[cardPath])
+}
@@ -0,0 +1,19 @@
+package vue
+
+import (
+	"fmt"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/sfc"
+)
+
+// ParseVueImports parses a Vue single-file component and returns the project-internal
+// import paths from its <script> and <script setup> blocks. Blocks with lang="ts"
+// are parsed as TypeScript.
+func ParseVueImports(sourceCode []byte) ([]string, error) {
+	blocks, err := sfc.ParseScriptBlocks(sourceCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Vue code: %w", err)
+	}
+
+	return sfc.InternalImportPaths(blocks), nil
+}
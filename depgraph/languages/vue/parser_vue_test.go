@@ -0,0 +1,38 @@
+package vue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVueImports_ScriptAndScriptSetup(t *testing.T) {
+	source := `<template>
+  <UserCard :user="user" @select="onSelect" />
+</template>
+
+<script>
+import { defineComponent } from 'vue'
+import legacy from './legacy'
+export default defineComponent({})
+</script>
+
+<script setup lang="ts">
+import UserCard from './UserCard.vue'
+import type { User } from '@/types/user'
+</script>
+`
+
+	imports, err := ParseVueImports([]byte(source))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"./legacy", "./UserCard.vue", "@/types/user"}, imports)
+}
+
+func TestParseVueImports_TemplateOnly(t *testing.T) {
+	imports, err := ParseVueImports([]byte("<template><p>Static</p></template>\n"))
+
+	require.NoError(t, err)
+	assert.Empty(t, imports)
+}
@@ -0,0 +1,14 @@
+package vue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestFile(t *testing.T) {
+	assert.True(t, IsTestFile("src/components/UserCard.spec.vue"))
+	assert.True(t, IsTestFile("src/components/__tests__/Harness.vue"))
+	assert.False(t, IsTestFile("src/components/UserCard.vue"))
+	assert.False(t, IsTestFile("src/components/UserCard.spec.ts"))
+}
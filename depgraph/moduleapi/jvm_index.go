@@ -0,0 +1,107 @@
+package moduleapi
+
+import (
+	"sort"
+	"strings"
+)
+
+// JVMIndex maps packages to the files and top-level types declared in them across
+// every JVM language in the graph, so Kotlin can depend on Java, Java on Scala, and so on.
+type JVMIndex struct {
+	PackageFiles map[string][]string
+	PackageTypes map[string]map[string][]string
+	FilePackages map[string]string
+}
+
+// Maps returns the index as the package, type and file maps used by the Java and Kotlin resolvers.
+func (idx *JVMIndex) Maps() (map[string][]string, map[string]map[string][]string, map[string]string) {
+	if idx == nil {
+		return nil, nil, make(map[string]string)
+	}
+	return idx.PackageFiles, idx.PackageTypes, idx.FilePackages
+}
+
+// HasPackage reports whether any indexed file declares the package.
+func (idx *JVMIndex) HasPackage(pkg string) bool {
+	if idx == nil {
+		return false
+	}
+	_, ok := idx.PackageFiles[pkg]
+	return ok
+}
+
+// TypeFile returns the single file declaring typeName in pkg.
+// Returns an empty string when the type is unknown or declared more than once.
+func (idx *JVMIndex) TypeFile(pkg, typeName string) string {
+	if idx == nil {
+		return ""
+	}
+	files := idx.PackageTypes[pkg][typeName]
+	if len(files) != 1 {
+		return ""
+	}
+	return files[0]
+}
+
+// ResolveImport resolves a dot-delimited import path to the files declaring the imported types.
+// Single-type imports may name a member of the type (for example a static import), and are
+// matched against the longest indexed package prefix. Wildcard imports only resolve the types
+// in referencedTypes, so unused members of a package do not become dependencies.
+func (idx *JVMIndex) ResolveImport(importPath string, isWildcard bool, referencedTypes map[string]bool) []string {
+	if idx == nil || importPath == "" {
+		return nil
+	}
+
+	parts := strings.Split(importPath, ".")
+
+	if isWildcard {
+		if idx.HasPackage(importPath) {
+			var files []string
+			for ref := range referencedTypes {
+				if file := idx.TypeFile(importPath, ref); file != "" {
+					files = append(files, file)
+				}
+			}
+			sort.Strings(files)
+			return files
+		}
+	}
+
+	for i := len(parts) - 1; i >= 1; i-- {
+		pkg := strings.Join(parts[:i], ".")
+		if !idx.HasPackage(pkg) {
+			continue
+		}
+		if file := idx.TypeFile(pkg, parts[i]); file != "" {
+			return []string{file}
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// ResolveSamePackageReferences resolves type references that need no import because the
+// referenced type lives in the same package as the source file.
+func (idx *JVMIndex) ResolveSamePackageReferences(sourceFile string, typeReferences []string, excludedNames map[string]bool) []string {
+	if idx == nil {
+		return nil
+	}
+
+	pkg, ok := idx.FilePackages[sourceFile]
+	if !ok {
+		return nil
+	}
+
+	var deps []string
+	for _, ref := range typeReferences {
+		if excludedNames[ref] {
+			continue
+		}
+		if file := idx.TypeFile(pkg, ref); file != "" && file != sourceFile {
+			deps = append(deps, file)
+		}
+	}
+
+	return deps
+}
@@ -10,3 +10,9 @@ type Module interface {
 	NewResolver(ctx *Context, contentReader vcs.ContentReader) Resolver
 	IsTestFile(filePath string, contentReader vcs.ContentReader) bool
 }
+
+// ImportLocatingModule is implemented by modules whose resolvers also implement ImportLocator.
+type ImportLocatingModule interface {
+	Module
+	LocatesImports() bool
+}
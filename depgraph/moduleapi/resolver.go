@@ -8,6 +8,12 @@ type Graph interface {
 	AddEdge(sourceHash, targetHash string, options ...func(*graphlib.EdgeProperties)) error
 }
 
+// EdgeRelationAttribute is the edge attribute key resolvers use to tag edges that are not imports.
+const EdgeRelationAttribute = "relation"
+
+// RelationGeneratedFrom tags an edge from generated code to the source it was generated from.
+const RelationGeneratedFrom = "generated-from"
+
 // Resolver resolves project imports for one language and can finalize graph-wide state.
 type Resolver interface {
 	ResolveProjectImports(absPath, filePath, ext string) ([]string, error)
@@ -21,4 +27,42 @@ type Context struct {
 	JavaFiles     []string
 	KotlinFiles   []string
 	GoFiles       []string
+	// JVMIndex indexes packages and types across Java, Kotlin, Scala and Groovy files.
+	JVMIndex *JVMIndex
+	// Options holds the user's configuration for optional analysis.
+	Options BuildOptions
+}
+
+// BuildOptions configures optional analysis. The zero value is the default analysis.
+type BuildOptions struct {
+	// ProtoRoots are absolute .proto import roots, searched after the roots declared in buf
+	// configuration and before the ancestor directories of the importing file.
+	ProtoRoots []string
+	// LinkGeneratedProtoFiles adds a generated-from edge from protoc output, such as *.pb.go
+	// or *_pb2.py, to the .proto file it was generated from.
+	LinkGeneratedProtoFiles bool
+	// LocateImports records the import statement behind each edge. Languages that support it
+	// parse every file a second time to find them.
+	LocateImports bool
+}
+
+// ImportSite is an import statement as written in a source file.
+type ImportSite struct {
+	// Specifier is the imported path or module name, without quotes.
+	Specifier string
+	// Line and Column are 1-based and point at the start of the import statement or require() call.
+	Line   int
+	Column int
+}
+
+// ImportReference is an import site together with a project file it resolves to.
+type ImportReference struct {
+	ImportSite
+	Target string
+}
+
+// ImportLocator is implemented by resolvers that can report where each project import is declared.
+// References may name files that are not dependencies; only edges present in the graph are annotated.
+type ImportLocator interface {
+	LocateProjectImports(absPath, filePath, ext string) ([]ImportReference, error)
 }
@@ -0,0 +1,39 @@
+package registry
+
+import (
+	"path/filepath"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/groovy"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/java"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/jvm"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/kotlin"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/scala"
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+var jvmDeclarationParsers = map[string]jvm.DeclarationParser{
+	".java":   {Package: java.ParsePackageDeclaration, TopLevelTypes: java.ParseTopLevelTypeNames},
+	".kt":     {Package: kotlin.ExtractPackageDeclaration, TopLevelTypes: kotlin.ExtractTopLevelTypeNames},
+	".kts":    {Package: kotlin.ExtractPackageDeclaration, TopLevelTypes: kotlin.ExtractTopLevelTypeNames},
+	".scala":  {Package: scala.ParsePackageDeclaration, TopLevelTypes: scala.ParseTopLevelTypeNames},
+	".sc":     {Package: scala.ParsePackageDeclaration, TopLevelTypes: scala.ParseTopLevelTypeNames},
+	".groovy": {Package: groovy.ParsePackageDeclaration, TopLevelTypes: groovy.ParseTopLevelTypeNames},
+	".gradle": {Package: groovy.ParsePackageDeclaration, TopLevelTypes: groovy.ParseTopLevelTypeNames},
+}
+
+// BuildJVMIndex builds the package index shared by the Java, Kotlin, Scala and Groovy
+// resolvers so that imports and same-package references resolve across JVM languages.
+// Returns nil when no JVM files are supplied.
+func BuildJVMIndex(suppliedFiles map[string]bool, contentReader vcs.ContentReader) *JVMIndex {
+	var jvmFiles []string
+	for filePath, exists := range suppliedFiles {
+		if _, ok := jvmDeclarationParsers[filepath.Ext(filePath)]; exists && ok {
+			jvmFiles = append(jvmFiles, filePath)
+		}
+	}
+	if len(jvmFiles) == 0 {
+		return nil
+	}
+
+	return jvm.BuildIndex(jvmFiles, contentReader, jvmDeclarationParsers)
+}
@@ -1,37 +1,53 @@
 package registry
 
 import (
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/astro"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/c"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/cpp"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/csharp"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/dart"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/elixir"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/erlang"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/golang"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/groovy"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/java"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/javascript"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/kotlin"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/php"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/protobuf"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/python"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/ruby"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/rust"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/scala"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/svelte"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/swift"
 	"github.com/LegacyCodeHQ/clarity/depgraph/languages/typescript"
+	"github.com/LegacyCodeHQ/clarity/depgraph/languages/vue"
 )
 
 var modules = []Module{
+	astro.Module{},
 	c.Module{},
 	cpp.Module{},
 	csharp.Module{},
 	dart.Module{},
+	elixir.Module{},
+	erlang.Module{},
 	golang.Module{},
+	groovy.Module{},
 	javascript.Module{},
 	java.Module{},
 	kotlin.Module{},
+	php.Module{},
+	protobuf.Module{},
 	python.Module{},
 	ruby.Module{},
 	rust.Module{},
+	scala.Module{},
 	svelte.Module{},
 	swift.Module{},
 	typescript.Module{},
+	vue.Module{},
 }
 
 // Modules returns supported language modules in deterministic order.
@@ -5,8 +5,43 @@ import "github.com/LegacyCodeHQ/clarity/depgraph/moduleapi"
 // Graph is the minimal graph contract language resolvers need during finalization.
 type Graph = moduleapi.Graph
 
+// EdgeRelationAttribute is the edge attribute key resolvers use to tag edges that are not imports.
+const EdgeRelationAttribute = moduleapi.EdgeRelationAttribute
+
+// RelationGeneratedFrom tags an edge from generated code to the source it was generated from.
+const RelationGeneratedFrom = moduleapi.RelationGeneratedFrom
+
 // Resolver resolves project imports for one language and can finalize graph-wide state.
 type Resolver = moduleapi.Resolver
 
 // Context contains precomputed project data shared across language resolvers.
 type Context = moduleapi.Context
+
+// BuildOptions configures optional analysis.
+type BuildOptions = moduleapi.BuildOptions
+
+// JVMIndex maps packages to the files and types declared in them across JVM languages.
+type JVMIndex = moduleapi.JVMIndex
+
+// ImportSite is an import statement as written in a source file.
+type ImportSite = moduleapi.ImportSite
+
+// ImportReference is an import site together with a project file it resolves to.
+type ImportReference = moduleapi.ImportReference
+
+// ImportLocator is implemented by resolvers that can report where each project import is declared.
+type ImportLocator = moduleapi.ImportLocator
+
+// ImportLocatingModule is implemented by modules whose resolvers also implement ImportLocator.
+type ImportLocatingModule = moduleapi.ImportLocatingModule
+
+// LocatesImports reports whether the language registered for the extension can report where
+// each project import is declared.
+func LocatesImports(ext string) bool {
+	module, ok := moduleForExtension(ext)
+	if !ok {
+		return false
+	}
+	locating, ok := module.(ImportLocatingModule)
+	return ok && locating.LocatesImports()
+}
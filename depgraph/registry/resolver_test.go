@@ -0,0 +1,24 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+func TestLocatesImports_MatchesResolverCapabilities(t *testing.T) {
+	for _, module := range Modules() {
+		_, locates := module.NewResolver(&Context{}, vcs.FilesystemContentReader()).(ImportLocator)
+		for _, ext := range module.Extensions() {
+			if got := LocatesImports(ext); got != locates {
+				t.Fatalf("LocatesImports(%q) = %v, but the %s resolver implementing ImportLocator is %v", ext, got, module.Name(), locates)
+			}
+		}
+	}
+}
+
+func TestLocatesImports_UnknownExtension(t *testing.T) {
+	if LocatesImports(".unknown") {
+		t.Fatalf("LocatesImports(.unknown) = true, want false")
+	}
+}
@@ -8,18 +8,31 @@ func TestSupportedLanguages(t *testing.T) {
 		t.Fatalf("SupportedLanguages() returned no languages")
 	}
 
+	foundAstro := false
 	foundC := false
 	foundCpp := false
 	foundCSharp := false
+	foundElixir := false
+	foundErlang := false
+	foundGroovy := false
 	foundJavaScript := false
+	foundPHP := false
+	foundProtobuf := false
 	foundPython := false
 	foundRuby := false
 	foundRust := false
+	foundScala := false
 	foundSvelte := false
 	foundSwift := false
 	foundTypeScript := false
+	foundVue := false
 	for _, language := range languages {
 		switch language.Name {
+		case "Astro":
+			foundAstro = true
+			if len(language.Extensions) != 1 {
+				t.Fatalf("Astro extension count = %d, want 1", len(language.Extensions))
+			}
 		case "C":
 			foundC = true
 			if len(language.Extensions) != 2 {
@@ -35,11 +48,36 @@ func TestSupportedLanguages(t *testing.T) {
 			if len(language.Extensions) != 1 {
 				t.Fatalf("C# extension count = %d, want 1", len(language.Extensions))
 			}
+		case "Elixir":
+			foundElixir = true
+			if len(language.Extensions) != 2 {
+				t.Fatalf("Elixir extension count = %d, want 2", len(language.Extensions))
+			}
+		case "Erlang":
+			foundErlang = true
+			if len(language.Extensions) != 2 {
+				t.Fatalf("Erlang extension count = %d, want 2", len(language.Extensions))
+			}
+		case "Groovy":
+			foundGroovy = true
+			if len(language.Extensions) != 2 {
+				t.Fatalf("Groovy extension count = %d, want 2", len(language.Extensions))
+			}
 		case "JavaScript":
 			foundJavaScript = true
 			if len(language.Extensions) != 4 {
 				t.Fatalf("JavaScript extension count = %d, want 4", len(language.Extensions))
 			}
+		case "PHP":
+			foundPHP = true
+			if len(language.Extensions) != 1 {
+				t.Fatalf("PHP extension count = %d, want 1", len(language.Extensions))
+			}
+		case "Protocol Buffers":
+			foundProtobuf = true
+			if len(language.Extensions) != 1 {
+				t.Fatalf("Protocol Buffers extension count = %d, want 1", len(language.Extensions))
+			}
 		case "Python":
 			foundPython = true
 			if len(language.Extensions) != 1 {
@@ -55,6 +93,11 @@ func TestSupportedLanguages(t *testing.T) {
 			if len(language.Extensions) != 1 {
 				t.Fatalf("Rust extension count = %d, want 1", len(language.Extensions))
 			}
+		case "Scala":
+			foundScala = true
+			if len(language.Extensions) != 2 {
+				t.Fatalf("Scala extension count = %d, want 2", len(language.Extensions))
+			}
 		case "Svelte":
 			foundSvelte = true
 			if len(language.Extensions) != 1 {
@@ -70,9 +113,17 @@ func TestSupportedLanguages(t *testing.T) {
 			if len(language.Extensions) != 2 {
 				t.Fatalf("TypeScript extension count = %d, want 2", len(language.Extensions))
 			}
+		case "Vue":
+			foundVue = true
+			if len(language.Extensions) != 1 {
+				t.Fatalf("Vue extension count = %d, want 1", len(language.Extensions))
+			}
 		}
 	}
 
+	if !foundAstro {
+		t.Fatalf("SupportedLanguages() missing Astro")
+	}
 	if !foundC {
 		t.Fatalf("SupportedLanguages() missing C")
 	}
@@ -82,9 +133,24 @@ func TestSupportedLanguages(t *testing.T) {
 	if !foundCSharp {
 		t.Fatalf("SupportedLanguages() missing C#")
 	}
+	if !foundElixir {
+		t.Fatalf("SupportedLanguages() missing Elixir")
+	}
+	if !foundErlang {
+		t.Fatalf("SupportedLanguages() missing Erlang")
+	}
+	if !foundGroovy {
+		t.Fatalf("SupportedLanguages() missing Groovy")
+	}
 	if !foundJavaScript {
 		t.Fatalf("SupportedLanguages() missing JavaScript")
 	}
+	if !foundPHP {
+		t.Fatalf("SupportedLanguages() missing PHP")
+	}
+	if !foundProtobuf {
+		t.Fatalf("SupportedLanguages() missing Protocol Buffers")
+	}
 	if !foundPython {
 		t.Fatalf("SupportedLanguages() missing Python")
 	}
@@ -94,6 +160,9 @@ func TestSupportedLanguages(t *testing.T) {
 	if !foundRust {
 		t.Fatalf("SupportedLanguages() missing Rust")
 	}
+	if !foundScala {
+		t.Fatalf("SupportedLanguages() missing Scala")
+	}
 	if !foundSvelte {
 		t.Fatalf("SupportedLanguages() missing Svelte")
 	}
@@ -103,6 +172,9 @@ func TestSupportedLanguages(t *testing.T) {
 	if !foundTypeScript {
 		t.Fatalf("SupportedLanguages() missing TypeScript")
 	}
+	if !foundVue {
+		t.Fatalf("SupportedLanguages() missing Vue")
+	}
 }
 
 func TestIsSupportedLanguageExtension(t *testing.T) {
@@ -130,6 +202,12 @@ func TestIsSupportedLanguageExtension(t *testing.T) {
 	if !IsSupportedLanguageExtension(".cjs") {
 		t.Fatalf("IsSupportedLanguageExtension(.cjs) = false, want true")
 	}
+	if !IsSupportedLanguageExtension(".php") {
+		t.Fatalf("IsSupportedLanguageExtension(.php) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".proto") {
+		t.Fatalf("IsSupportedLanguageExtension(.proto) = false, want true")
+	}
 	if !IsSupportedLanguageExtension(".py") {
 		t.Fatalf("IsSupportedLanguageExtension(.py) = false, want true")
 	}
@@ -139,6 +217,36 @@ func TestIsSupportedLanguageExtension(t *testing.T) {
 	if !IsSupportedLanguageExtension(".rs") {
 		t.Fatalf("IsSupportedLanguageExtension(.rs) = false, want true")
 	}
+	if !IsSupportedLanguageExtension(".ex") {
+		t.Fatalf("IsSupportedLanguageExtension(.ex) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".exs") {
+		t.Fatalf("IsSupportedLanguageExtension(.exs) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".erl") {
+		t.Fatalf("IsSupportedLanguageExtension(.erl) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".hrl") {
+		t.Fatalf("IsSupportedLanguageExtension(.hrl) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".scala") {
+		t.Fatalf("IsSupportedLanguageExtension(.scala) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".sc") {
+		t.Fatalf("IsSupportedLanguageExtension(.sc) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".groovy") {
+		t.Fatalf("IsSupportedLanguageExtension(.groovy) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".gradle") {
+		t.Fatalf("IsSupportedLanguageExtension(.gradle) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".vue") {
+		t.Fatalf("IsSupportedLanguageExtension(.vue) = false, want true")
+	}
+	if !IsSupportedLanguageExtension(".astro") {
+		t.Fatalf("IsSupportedLanguageExtension(.astro) = false, want true")
+	}
 	if !IsSupportedLanguageExtension(".svelte") {
 		t.Fatalf("IsSupportedLanguageExtension(.svelte) = false, want true")
 	}
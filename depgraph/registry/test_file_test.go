@@ -138,6 +138,86 @@ func TestIsTestFile(t *testing.T) {
 			filePath: "/project/src/App.svelte",
 			want:     false,
 		},
+		{
+			name:     "elixir exunit test",
+			filePath: "/project/test/my_app/accounts_test.exs",
+			want:     true,
+		},
+		{
+			name:     "elixir test helper",
+			filePath: "/project/test/test_helper.exs",
+			want:     true,
+		},
+		{
+			name:     "elixir test support module",
+			filePath: "/project/test/support/conn_case.ex",
+			want:     true,
+		},
+		{
+			name:     "elixir source",
+			filePath: "/project/lib/my_app/accounts.ex",
+			want:     false,
+		},
+		{
+			name:     "erlang common test suite",
+			filePath: "/project/apps/session/test/session_SUITE.erl",
+			want:     true,
+		},
+		{
+			name:     "erlang non-test file",
+			filePath: "/project/apps/session/src/session_server.erl",
+			want:     false,
+		},
+		{
+			name:     "scala spec suffix",
+			filePath: "/project/src/test/scala/com/acme/InvoiceSpec.scala",
+			want:     true,
+		},
+		{
+			name:     "scala non-test file",
+			filePath: "/project/src/main/scala/com/acme/Invoice.scala",
+			want:     false,
+		},
+		{
+			name:     "groovy spock spec suffix",
+			filePath: "/project/src/test/groovy/com/acme/BuilderSpec.groovy",
+			want:     true,
+		},
+		{
+			name:     "gradle build script",
+			filePath: "/project/app/build.gradle",
+			want:     false,
+		},
+		{
+			name:     "php test suffix",
+			filePath: "/project/tests/Unit/UserTest.php",
+			want:     true,
+		},
+		{
+			name:     "php non-test file",
+			filePath: "/project/src/Models/User.php",
+			want:     false,
+		},
+		{
+			name:     "vue spec suffix",
+			filePath: "/project/src/components/UserCard.spec.vue",
+			want:     true,
+		},
+		{
+			name:     "vue non-test file",
+			filePath: "/project/src/components/UserCard.vue",
+			want:     false,
+		},
+		{
+			name:     "protobuf test suffix",
+			filePath: "/project/proto/payments_test.proto",
+			want:     true,
+		},
+		{
+			name:     "protobuf non-test file",
+			filePath: "/project/proto/payments.proto",
+			want:     false,
+		},
 		{
 			name:     "python test prefix",
 			filePath: "/project/tests/test_handlers.py",
@@ -0,0 +1,26 @@
+// Package graphflags registers the flags that configure dependency-graph analysis, so every
+// command that builds a graph accepts the same ones.
+package graphflags
+
+import (
+	"path/filepath"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph"
+	"github.com/spf13/cobra"
+)
+
+// Register adds the analysis flags to cmd and binds them to opts.
+func Register(cmd *cobra.Command, opts *depgraph.BuildOptions) {
+	cmd.Flags().StringArrayVar(&opts.ProtoRoots, "proto-root", nil, "Additional import root for .proto files relative to --repo, like protoc -I (repeatable)")
+	cmd.Flags().BoolVar(&opts.LinkGeneratedProtoFiles, "proto-generated-edges", false, "Link generated protobuf code such as *.pb.go and *_pb2.py to its .proto file")
+}
+
+// ResolveRoots anchors relative --proto-root values at repoPath, so they name the same
+// directory whichever directory the command runs from.
+func ResolveRoots(opts *depgraph.BuildOptions, repoPath string) {
+	for i, root := range opts.ProtoRoots {
+		if !filepath.IsAbs(root) {
+			opts.ProtoRoots[i] = filepath.Join(repoPath, root)
+		}
+	}
+}
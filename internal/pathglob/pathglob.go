@@ -0,0 +1,49 @@
+// Package pathglob matches slash-separated paths against glob patterns with "**" segments.
+package pathglob
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Match reports whether a slash-separated path matches pattern. Segments follow path.Match,
+// and a "**" segment matches any number of directories, including none.
+func Match(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// Validate rejects patterns with malformed segments, which Match would otherwise treat as never
+// matching.
+func Validate(pattern string) error {
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("bad glob %q", pattern)
+		}
+	}
+	return nil
+}
+
+func matchSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+	matched, err := path.Match(pattern[0], name[0])
+	if err != nil || !matched {
+		return false
+	}
+	return matchSegments(pattern[1:], name[1:])
+}
@@ -0,0 +1,35 @@
+package pathglob
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"/repo/src/payments/**", "/repo/src/payments/api.ts", true},
+		{"/repo/src/payments/**", "/repo/src/payments/stripe/client.ts", true},
+		{"/repo/src/payments/**", "/repo/src/orders/api.ts", false},
+		{"/repo/src/**/*.test.ts", "/repo/src/api.test.ts", true},
+		{"/repo/src/**/*.test.ts", "/repo/src/payments/api.test.ts", true},
+		{"/repo/src/**/*.test.ts", "/repo/src/payments/api.ts", false},
+		{"/repo/src/*.ts", "/repo/src/payments/api.ts", false},
+		{"/repo/src/?.ts", "/repo/src/a.ts", true},
+		{"src/ui/**", "src/ui/button.ts", true},
+		{"src/[ui", "src/[ui", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Match(tt.pattern, tt.name), "Match(%q, %q)", tt.pattern, tt.name)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("src/**/*.ts"))
+	assert.EqualError(t, Validate("src/[ui"), `bad glob "src/[ui"`)
+}
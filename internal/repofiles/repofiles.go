@@ -0,0 +1,76 @@
+// Package repofiles finds the source files of a repository and names them relative to its root.
+package repofiles
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/depgraph/registry"
+)
+
+// skippedDirs hold version control metadata, installed dependencies, build output or editor
+// state rather than project sources.
+var skippedDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"target":       true,
+	".dart_tool":   true,
+	"build":        true,
+	"__pycache__":  true,
+	".gradle":      true,
+	".idea":        true,
+	".vscode":      true,
+}
+
+// IsSkippedDir reports whether directories with this name are left out of walks and watches.
+func IsSkippedDir(name string) bool {
+	return skippedDirs[name]
+}
+
+// CollectSupported returns the files under roots written in a supported language. Skipped
+// directories are not descended into unless they are a root themselves.
+func CollectSupported(roots ...string) ([]string, error) {
+	files := make([]string, 0, 256)
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			if d.IsDir() {
+				if path != root && IsSkippedDir(d.Name()) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if registry.IsSupportedLanguageExtension(filepath.Ext(path)) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// DisplayPath returns absolutePath relative to repoRoot, or unchanged when it lies outside.
+func DisplayPath(repoRoot, absolutePath string) string {
+	rel, err := filepath.Rel(repoRoot, absolutePath)
+	if err != nil || rel == "." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || rel == ".." {
+		return absolutePath
+	}
+	return rel
+}
+
+// DisplayPaths applies DisplayPath to every path.
+func DisplayPaths(repoRoot string, paths []string) []string {
+	result := make([]string, 0, len(paths))
+	for _, p := range paths {
+		result = append(result, DisplayPath(repoRoot, p))
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package repofiles
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectSupported_SkipsDependencyAndBuildDirectories(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{
+		"src/app.ts",
+		"src/README.md",
+		"node_modules/left-pad/index.js",
+		"vendor/github.com/pkg/errors/errors.go",
+		".git/hooks/pre-commit.py",
+	} {
+		path := filepath.Join(root, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(""), 0o644))
+	}
+
+	files, err := CollectSupported(root)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(root, "src", "app.ts")}, files)
+
+	files, err = CollectSupported(filepath.Join(root, "vendor"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(root, "vendor", "github.com", "pkg", "errors", "errors.go")}, files)
+}
+
+func TestDisplayPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("src", "app.ts"), DisplayPath("/repo", "/repo/src/app.ts"))
+	assert.Equal(t, "/elsewhere/app.ts", DisplayPath("/repo", "/elsewhere/app.ts"))
+	assert.Equal(t, "/repo", DisplayPath("/repo", "/repo"))
+}
@@ -14,6 +14,26 @@ func DotGoldie(t *testing.T) *goldie.Goldie {
 	return goldieWithExtension(t, "dot")
 }
 
+func PlantUMLGoldie(t *testing.T) *goldie.Goldie {
+	return goldieWithExtension(t, "puml")
+}
+
+func D2Goldie(t *testing.T) *goldie.Goldie {
+	return goldieWithExtension(t, "d2")
+}
+
+func GraphMLGoldie(t *testing.T) *goldie.Goldie {
+	return goldieWithExtension(t, "graphml")
+}
+
+func GEXFGoldie(t *testing.T) *goldie.Goldie {
+	return goldieWithExtension(t, "gexf")
+}
+
+func SVGGoldie(t *testing.T) *goldie.Goldie {
+	return goldieWithExtension(t, "svg")
+}
+
 func TextGoldie(t *testing.T) *goldie.Goldie {
 	return goldieWithExtension(t, "txt")
 }
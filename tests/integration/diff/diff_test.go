@@ -0,0 +1,353 @@
+package diff_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	diffcmd "github.com/LegacyCodeHQ/clarity/cmd/diff"
+	"github.com/LegacyCodeHQ/clarity/tests/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type deltaEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type delta struct {
+	SchemaVersion int `json:"schemaVersion"`
+	Comparison    struct {
+		Label string `json:"label"`
+		Base  struct {
+			Name   string `json:"name"`
+			Commit string `json:"commit"`
+		} `json:"base"`
+		Target struct {
+			Name   string `json:"name"`
+			Commit string `json:"commit"`
+		} `json:"target"`
+	} `json:"comparison"`
+	NodesAdded   []string `json:"nodesAdded"`
+	NodesRemoved []string `json:"nodesRemoved"`
+	NodesMoved   []struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"nodesMoved"`
+	ChangedNodes []struct {
+		Path  string `json:"path"`
+		Stats *struct {
+			Additions int  `json:"additions"`
+			Deletions int  `json:"deletions"`
+			IsNew     bool `json:"isNew"`
+		} `json:"stats"`
+	} `json:"changedNodes"`
+	EdgesAdded   []deltaEdge `json:"edgesAdded"`
+	EdgesRemoved []deltaEdge `json:"edgesRemoved"`
+	Findings     []struct {
+		RuleID   string   `json:"ruleId"`
+		Severity string   `json:"severity"`
+		Message  string   `json:"message"`
+		Paths    []string `json:"paths"`
+	} `json:"findings"`
+	CycleChanges []struct {
+		Kind        string   `json:"kind"`
+		Path        []string `json:"path"`
+		BaseNodes   []string `json:"baseNodes"`
+		TargetNodes []string `json:"targetNodes"`
+	} `json:"cycleChanges"`
+}
+
+func parseDelta(t *testing.T, output string) delta {
+	t.Helper()
+
+	var d delta
+	require.NoError(t, json.Unmarshal([]byte(output), &d), "output: %s", output)
+	return d
+}
+
+func changedPaths(d delta) []string {
+	paths := make([]string, 0, len(d.ChangedNodes))
+	for _, node := range d.ChangedNodes {
+		paths = append(paths, node.Path)
+	}
+	return paths
+}
+
+func newRepoWithTwoFiles(t *testing.T) string {
+	t.Helper()
+
+	repo := internal.NewGitRepo(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "export const a = 1;\n")
+	internal.WriteRepoFile(t, repo, "b.ts", "export const b = 1;\n")
+	internal.GitRun(t, repo, "add", ".")
+	internal.GitRun(t, repo, "commit", "-q", "-m", "initial")
+	return repo
+}
+
+func TestDiff_WorkingTree_FormatParity(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "-f", "json"))
+	assert.Equal(t, []deltaEdge{{From: "a.ts", To: "b.ts"}}, d.EdgesAdded)
+	assert.Equal(t, []string{"a.ts"}, changedPaths(d))
+	require.NotNil(t, d.ChangedNodes[0].Stats)
+	assert.Equal(t, 2, d.ChangedNodes[0].Stats.Additions)
+	assert.Equal(t, 1, d.ChangedNodes[0].Stats.Deletions)
+
+	summary := internal.DiffSubcommand(t, repo, "--summary")
+	assert.Equal(t, summary, internal.DiffSubcommand(t, repo, "-f", "text"))
+	assert.Contains(t, summary, "Edges added: 1\n"+filepath.Join(repo, "a.ts")+" -> "+filepath.Join(repo, "b.ts"))
+
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "-f", "dot"), `"`+filepath.Join(repo, "a.ts")+`" -> "`+filepath.Join(repo, "b.ts")+`"`)
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "-f", "mermaid"), "-->")
+}
+
+func TestDiff_Commit_RenamedFileIsReportedAsMove(t *testing.T) {
+	repo := internal.NewGitRepo(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.WriteRepoFile(t, repo, "b.ts", "export const b = 1;\nexport const c = 2;\nexport const d = 3;\n")
+	internal.GitRun(t, repo, "add", ".")
+	internal.GitRun(t, repo, "commit", "-q", "-m", "initial")
+
+	internal.GitRun(t, repo, "mv", "b.ts", "lib_b.ts")
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './lib_b';\nexport const a = b;\n")
+	internal.GitRun(t, repo, "commit", "-q", "-am", "rename b")
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "--commit", "HEAD", "-f", "json"))
+
+	assert.ElementsMatch(t, []string{"a.ts", "lib_b.ts"}, changedPaths(d))
+	assert.Empty(t, d.NodesAdded)
+	assert.Empty(t, d.NodesRemoved)
+	require.Len(t, d.NodesMoved, 1)
+	assert.Equal(t, "b.ts", d.NodesMoved[0].From)
+	assert.Equal(t, "lib_b.ts", d.NodesMoved[0].To)
+	// a.ts still imports the moved file, so no edge changed.
+	assert.Empty(t, d.EdgesAdded)
+	assert.Empty(t, d.EdgesRemoved)
+}
+
+func TestDiff_Staged_GitMvIsReportedAsMove(t *testing.T) {
+	repo := internal.NewGitRepo(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.WriteRepoFile(t, repo, "b.ts", "export const b = 1;\nexport const c = 2;\nexport const d = 3;\n")
+	internal.GitRun(t, repo, "add", ".")
+	internal.GitRun(t, repo, "commit", "-q", "-m", "initial")
+
+	internal.GitRun(t, repo, "mv", "b.ts", "lib_b.ts")
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './lib_b';\nexport const a = b;\n")
+	internal.GitRun(t, repo, "add", "a.ts")
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "--staged", "-f", "json"))
+
+	require.Len(t, d.NodesMoved, 1)
+	assert.Equal(t, "lib_b.ts", d.NodesMoved[0].To)
+	assert.Empty(t, d.EdgesAdded)
+	assert.Empty(t, d.EdgesRemoved)
+
+	summary := internal.DiffSubcommand(t, repo, "--staged", "--summary")
+	assert.Contains(t, summary, "Nodes moved: 1\n"+filepath.Join(repo, "b.ts")+" -> "+filepath.Join(repo, "lib_b.ts"))
+}
+
+func TestDiff_SideBySideImpactLayout(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+
+	out := internal.DiffSubcommand(t, repo, "--layout", "side-by-side", "--impact")
+
+	assert.Contains(t, out, `subgraph "cluster_base" {`)
+	assert.Contains(t, out, `"base:`+filepath.Join(repo, "a.ts")+`" -> "base:`+filepath.Join(repo, "b.ts")+`" [style=invis];`)
+	assert.Contains(t, out, `"target:`+filepath.Join(repo, "a.ts")+`" -> "target:`+filepath.Join(repo, "b.ts")+`" [color="#2e8b57"];`)
+}
+
+func TestDiff_OverlayLayoutWritesHTML(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+
+	out := internal.DiffSubcommand(t, repo, "--layout", "overlay")
+
+	assert.Contains(t, out, "<!DOCTYPE html>")
+	assert.Equal(t, 2, strings.Count(out, "<svg"))
+}
+
+func TestDiff_Commit_StashEntry(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.GitRun(t, repo, "stash")
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "--commit", "stash@{0}", "-f", "json"))
+
+	assert.Equal(t, []deltaEdge{{From: "a.ts", To: "b.ts"}}, d.EdgesAdded)
+	assert.Equal(t, "stash@{0}^", d.Comparison.Base.Name)
+	assert.Equal(t, "stash@{0}", d.Comparison.Target.Name)
+	assert.Len(t, d.Comparison.Target.Commit, 40)
+}
+
+func TestDiff_Commit_TagAndBranchWithMergeBase(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.GitRun(t, repo, "tag", "v1")
+	internal.GitRun(t, repo, "checkout", "-q", "-b", "feature")
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.GitRun(t, repo, "commit", "-q", "-am", "a imports b")
+	internal.GitRun(t, repo, "checkout", "-q", "main")
+	internal.WriteRepoFile(t, repo, "b.ts", "import { a } from './a';\nexport const b = 1;\n")
+	internal.GitRun(t, repo, "commit", "-q", "-am", "b imports a")
+
+	// Comparing the branches directly also reports main's own change as removed.
+	direct := parseDelta(t, internal.DiffSubcommand(t, repo, "--commit", "main,feature", "-f", "json"))
+	assert.Len(t, direct.EdgesRemoved, 1)
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "--commit", "main,feature", "--merge-base", "-f", "json"))
+	assert.Equal(t, []deltaEdge{{From: "a.ts", To: "b.ts"}}, d.EdgesAdded)
+	assert.Empty(t, d.EdgesRemoved)
+	assert.Equal(t, "merge-base of main and feature", d.Comparison.Base.Name)
+
+	tagged := parseDelta(t, internal.DiffSubcommand(t, repo, "--commit", "v1,feature", "-f", "json"))
+	assert.Equal(t, d.EdgesAdded, tagged.EdgesAdded)
+	assert.Equal(t, d.Comparison.Base.Commit, tagged.Comparison.Base.Commit)
+}
+
+func TestDiff_ComparisonLabelInEveryFormat(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.GitRun(t, repo, "add", "a.ts")
+
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--staged", "-f", "dot"), " → index\";\n")
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--staged", "-f", "mermaid"), "---\ntitle: HEAD (")
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--staged", "-f", "text"), "Comparing: HEAD (")
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--staged", "--summary"), "Comparing: HEAD (")
+	assert.Equal(t, "index", parseDelta(t, internal.DiffSubcommand(t, repo, "--staged", "-f", "json")).Comparison.Target.Name)
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--staged", "--layout", "overlay"), "<span>target: index</span>")
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--staged", "-f", "sarif"), `"comparison": "HEAD (`)
+}
+
+func TestDiff_NewCycleIsReportedAsFinding(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.WriteRepoFile(t, repo, "b.ts", "import { a } from './a';\nexport const b = a;\n")
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "-f", "json"))
+	assert.Equal(t, 1, d.SchemaVersion)
+	require.Len(t, d.CycleChanges, 1)
+	assert.Equal(t, "added", d.CycleChanges[0].Kind)
+	assert.Equal(t, []string{"a.ts", "b.ts"}, d.CycleChanges[0].TargetNodes)
+	require.Len(t, d.Findings, 1)
+	assert.Equal(t, "new-cycle", d.Findings[0].RuleID)
+	assert.Equal(t, "warning", d.Findings[0].Severity)
+	assert.Equal(t, []string{"a.ts", "b.ts"}, d.Findings[0].Paths)
+	assert.Equal(t, "new import cycle: a.ts -> b.ts -> a.ts", d.Findings[0].Message)
+
+	var sarif struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	output := internal.DiffSubcommand(t, repo, "-f", "sarif")
+	require.NoError(t, json.Unmarshal([]byte(output), &sarif), "output: %s", output)
+	assert.Equal(t, "2.1.0", sarif.Version)
+	require.Len(t, sarif.Runs, 1)
+	require.Len(t, sarif.Runs[0].Results, 1)
+	assert.Equal(t, "new-cycle", sarif.Runs[0].Results[0].RuleID)
+	require.NotEmpty(t, sarif.Runs[0].Results[0].Locations)
+	assert.Equal(t, "a.ts", sarif.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+
+	assert.Contains(t, internal.DiffSubcommand(t, repo, "--summary"), "warning new-cycle: new import cycle: ")
+}
+
+func TestDiff_FailOnPolicies(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.WriteRepoFile(t, repo, "b.ts", "import { a } from './a';\nexport const b = a;\n")
+
+	output, err := internal.DiffSubcommandResult(repo, "-f", "json", "--fail-on", "new-cycle", "--fail-on", "edge-added:a.ts->*.ts")
+	var violation *diffcmd.PolicyViolationError
+	require.ErrorAs(t, err, &violation)
+	assert.Equal(t, diffcmd.ExitCodePolicyViolation, violation.ExitCode())
+	assert.Len(t, violation.Violations, 2)
+	assert.Contains(t, violation.Violations, "edge-added:a.ts->*.ts: a.ts -> b.ts")
+	assert.Len(t, parseDelta(t, output).EdgesAdded, 2, "the delta is still written")
+
+	_, err = internal.DiffSubcommandResult(repo, "--summary", "--fail-on", "edge-added:lib/**->**", "--fail-on", "fan-in>1")
+	assert.NoError(t, err)
+
+	_, err = internal.DiffSubcommandResult(repo, "--fail-on", "fan-in>x")
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &violation))
+}
+
+func TestDiff_DetachedHEAD(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.GitRun(t, repo, "commit", "-q", "-am", "a imports b")
+	internal.GitRun(t, repo, "checkout", "-q", "--detach", "HEAD")
+	internal.WriteRepoFile(t, repo, "b.ts", "import { a } from './a';\nexport const b = 1;\n")
+
+	d := parseDelta(t, internal.DiffSubcommand(t, repo, "--commit", "HEAD", "-f", "json"))
+	assert.Len(t, d.EdgesAdded, 1)
+
+	d = parseDelta(t, internal.DiffSubcommand(t, repo, "-f", "json"))
+	assert.Equal(t, []deltaEdge{{From: "b.ts", To: "a.ts"}}, d.EdgesAdded)
+}
+
+func TestDiff_UnbornHEAD_ReturnsError(t *testing.T) {
+	repo := internal.NewGitRepo(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "export const a = 1;\n")
+
+	_, err := internal.DiffSubcommandResult(repo)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HEAD has no commits yet")
+
+	_, err = internal.DiffSubcommandResult(repo, "--commit", "HEAD")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "(HEAD has no commits yet)")
+}
+
+func TestDiff_ShallowClone_ReturnsErrorForMissingParent(t *testing.T) {
+	source := newRepoWithTwoFiles(t)
+	internal.WriteRepoFile(t, source, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.GitRun(t, source, "commit", "-q", "-am", "a imports b")
+
+	clone := filepath.Join(t.TempDir(), "clone")
+	internal.GitRun(t, source, "clone", "-q", "--depth", "1", "file://"+source, clone)
+
+	_, err := internal.DiffSubcommandResult(clone, "--commit", "HEAD")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the parent is missing from this shallow clone")
+
+	_, err = internal.DiffSubcommandResult(clone, "--commit", "HEAD~1,HEAD")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the repository is a shallow clone")
+}
+
+func TestDiff_Staged_WithMergeConflict_ReturnsError(t *testing.T) {
+	repo := newRepoWithTwoFiles(t)
+	internal.GitRun(t, repo, "checkout", "-q", "-b", "feature")
+	internal.WriteRepoFile(t, repo, "a.ts", "export const a = 2;\n")
+	internal.GitRun(t, repo, "commit", "-q", "-am", "feature change")
+	internal.GitRun(t, repo, "checkout", "-q", "main")
+	internal.WriteRepoFile(t, repo, "a.ts", "export const a = 3;\n")
+	internal.GitRun(t, repo, "commit", "-q", "-am", "main change")
+
+	merge := exec.Command("git", "merge", "feature")
+	merge.Dir = repo
+	require.Error(t, merge.Run(), "expected merge conflict")
+
+	_, err := internal.DiffSubcommandResult(repo, "--staged")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unresolved merge conflicts in "+filepath.Join(repo, "a.ts"))
+}
@@ -0,0 +1,46 @@
+package why_test
+
+import (
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/tests/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhy_FormatParity(t *testing.T) {
+	repo := internal.NewGitRepo(t)
+	internal.WriteRepoFile(t, repo, "src/app.ts", "import { util } from './util';\nexport const app = util;\n")
+	internal.WriteRepoFile(t, repo, "src/util.ts", "export const util = 1;\n")
+
+	text := internal.WhySubcommand(t, repo, "src/app.ts", "src/util.ts")
+	assert.Equal(t, "Direct connection(s) between src/app.ts and src/util.ts:\n"+
+		"- src/app.ts depends on src/util.ts\n"+
+		"  imports:\n"+
+		"    - src/app.ts:1:1 \"./util\"", text)
+
+	assert.JSONEq(t, `{
+		"from": "src/app.ts",
+		"to": "src/util.ts",
+		"connections": [
+			{
+				"from": "src/app.ts",
+				"to": "src/util.ts",
+				"type": "dependency",
+				"imports": [{"line": 1, "column": 1, "specifier": "./util"}]
+			}
+		]
+	}`, internal.WhySubcommand(t, repo, "-f", "json", "src/app.ts", "src/util.ts"))
+
+	assert.Contains(t, internal.WhySubcommand(t, repo, "-f", "dot", "src/app.ts", "src/util.ts"), `[label="src/app.ts", shape=box]`)
+	assert.Contains(t, internal.WhySubcommand(t, repo, "-f", "mermaid", "src/app.ts", "src/util.ts"), "n0 --> n1")
+}
+
+func TestWhy_ReverseDirection(t *testing.T) {
+	repo := internal.NewGitRepo(t)
+	internal.WriteRepoFile(t, repo, "a.ts", "import { b } from './b';\nexport const a = b;\n")
+	internal.WriteRepoFile(t, repo, "b.ts", "export const b = 1;\n")
+
+	output := internal.WhySubcommand(t, repo, "b.ts", "a.ts")
+
+	assert.Contains(t, output, "- a.ts depends on b.ts")
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	diffcmd "github.com/LegacyCodeHQ/clarity/cmd/diff"
 	graphcmd "github.com/LegacyCodeHQ/clarity/cmd/show"
+	whycmd "github.com/LegacyCodeHQ/clarity/cmd/why"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,6 +67,47 @@ func GraphSubcommandInputWithRepo(t *testing.T, repoPath string, inputs ...strin
 	return strings.TrimRight(stdout.String(), "\n")
 }
 
+// DiffSubcommand runs clarity diff against repoPath and returns its output.
+func DiffSubcommand(t *testing.T, repoPath string, args ...string) string {
+	t.Helper()
+
+	output, err := DiffSubcommandResult(repoPath, args...)
+	require.NoError(t, err)
+	return output
+}
+
+// DiffSubcommandResult runs clarity diff against repoPath and returns its output and error.
+func DiffSubcommandResult(repoPath string, args ...string) (string, error) {
+	cmd := diffcmd.NewCommand()
+	cmd.SetArgs(append([]string{"-r", repoPath}, args...))
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+
+	err := cmd.Execute()
+	return strings.TrimRight(stdout.String(), "\n"), err
+}
+
+// WhySubcommand runs clarity why against repoPath and returns its output.
+func WhySubcommand(t *testing.T, repoPath string, args ...string) string {
+	t.Helper()
+
+	cmd := whycmd.NewCommand()
+	cmd.SetArgs(append([]string{"-r", repoPath}, args...))
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+
+	err := cmd.Execute()
+	require.NoError(t, err, "stderr: %s", strings.TrimSpace(stderr.String()))
+
+	return strings.TrimRight(stdout.String(), "\n")
+}
+
 func RepoRoot(t *testing.T) string {
 	t.Helper()
 
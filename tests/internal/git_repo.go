@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// NewGitRepo initializes a git repository with a "main" branch in a temporary directory and
+// returns its symlink-free path, which matches the paths git reports.
+func NewGitRepo(t *testing.T) string {
+	t.Helper()
+
+	repoPath, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	GitRun(t, repoPath, "init", "-q")
+	GitRun(t, repoPath, "config", "user.name", "test")
+	GitRun(t, repoPath, "config", "user.email", "test@example.com")
+	GitRun(t, repoPath, "symbolic-ref", "HEAD", "refs/heads/main")
+	return repoPath
+}
+
+// GitRun runs a git command in repoPath and returns its trimmed output.
+func GitRun(t *testing.T, repoPath string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %s failed: %s", strings.Join(args, " "), output)
+	return strings.TrimSpace(string(output))
+}
+
+// WriteRepoFile writes content to a path relative to repoPath, creating parent directories.
+func WriteRepoFile(t *testing.T, repoPath, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(repoPath, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
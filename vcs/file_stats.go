@@ -6,3 +6,10 @@ type FileStats struct {
 	Deletions int
 	IsNew     bool
 }
+
+// FileChurn summarizes how often a file changed over the repository history.
+type FileChurn struct {
+	Commits   int
+	Additions int
+	Deletions int
+}
@@ -4,3 +4,9 @@ package git
 func ValidateCommit(repoPath, commitID string) error {
 	return validateCommit(repoPath, commitID)
 }
+
+// ResolveCommit returns the full hash of the commit that a reference such as a branch, tag,
+// stash@{n} or HEAD@{yesterday} points to.
+func ResolveCommit(repoPath, commitID string) (string, error) {
+	return resolveCommit(repoPath, commitID)
+}
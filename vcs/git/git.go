@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
+// reflogSelectorPattern matches the @{...} part of references such as stash@{0} or HEAD@{yesterday}.
+var reflogSelectorPattern = regexp.MustCompile(`@\{[^}]*\}`)
+
 // isGitRepository checks if the given path is inside a git repository
 func isGitRepository(path string) bool {
 	_, _, err := runGitCommand(path, "rev-parse", "--git-dir")
@@ -51,7 +55,8 @@ func validateGitRef(ref string) error {
 	if strings.HasPrefix(ref, "-") {
 		return fmt.Errorf("git reference cannot start with '-': %q", ref)
 	}
-	if strings.ContainsAny(ref, "\x00\n\r\t ") {
+	// Reflog selectors such as HEAD@{2 days ago} may contain spaces; nothing else may.
+	if strings.ContainsAny(ref, "\x00\n\r\t") || strings.Contains(reflogSelectorPattern.ReplaceAllString(ref, ""), " ") {
 		return fmt.Errorf("git reference contains whitespace or NUL: %q", ref)
 	}
 	return nil
@@ -76,19 +81,25 @@ func validateGitRelPath(path string) error {
 
 // validateCommit checks if the given commit reference exists in the repository
 func validateCommit(repoPath, commitID string) error {
+	_, err := resolveCommit(repoPath, commitID)
+	return err
+}
+
+// resolveCommit returns the full hash of the commit a reference points to.
+func resolveCommit(repoPath, commitID string) (string, error) {
 	if err := validateGitRef(commitID); err != nil {
-		return err
+		return "", err
 	}
 
-	_, stderr, err := runGitCommand(repoPath, "rev-parse", "--verify", commitID+"^{commit}")
+	stdout, stderr, err := runGitCommand(repoPath, "rev-parse", "--verify", commitID+"^{commit}")
 	if err != nil {
 		if stderr != "" {
-			return fmt.Errorf("invalid commit reference '%s': %s", commitID, stderr)
+			return "", fmt.Errorf("invalid commit reference '%s': %s", commitID, stderr)
 		}
-		return fmt.Errorf("invalid commit reference '%s'", commitID)
+		return "", fmt.Errorf("invalid commit reference '%s'", commitID)
 	}
 
-	return nil
+	return strings.TrimSpace(string(stdout)), nil
 }
 
 // GetCurrentCommitHash returns the current commit hash (HEAD)
@@ -165,6 +176,46 @@ func isAncestor(repoPath, possibleAncestor, possibleDescendant string) (bool, er
 	return true, nil
 }
 
+// GetMergeBase returns the best common ancestor of two commits, which is where a branch
+// forked from its base.
+func GetMergeBase(repoPath, base, branch string) (string, error) {
+	if err := validateCommit(repoPath, base); err != nil {
+		return "", err
+	}
+	if err := validateCommit(repoPath, branch); err != nil {
+		return "", err
+	}
+
+	stdout, stderr, err := runGitCommand(repoPath, "merge-base", base, branch)
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			return "", fmt.Errorf("'%s' and '%s' have no common ancestor", base, branch)
+		}
+		return "", gitCommandError(err, stderr)
+	}
+
+	return strings.TrimSpace(string(stdout)), nil
+}
+
+// GetDefaultBranch guesses the branch pull requests are merged into: the remote HEAD when
+// origin has one, otherwise main or master, whichever exists.
+func GetDefaultBranch(repoPath string) (string, error) {
+	stdout, _, err := runGitCommand(repoPath, "symbolic-ref", "--quiet", "--short", "refs/remotes/origin/HEAD")
+	if err == nil {
+		if ref := strings.TrimSpace(string(stdout)); ref != "" {
+			return ref, nil
+		}
+	}
+
+	for _, candidate := range []string{"main", "master"} {
+		if validateCommit(repoPath, candidate) == nil {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not determine the default branch (use --base to name it)")
+}
+
 // NormalizeCommitRange ensures commits are in chronological order (older first).
 // If the commits are reversed (newer...older), it swaps them.
 // Returns (olderCommit, newerCommit, swapped, error)
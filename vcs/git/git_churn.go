@@ -0,0 +1,65 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// GetHistoricalFileChurn returns, for every file touched in the history of HEAD, the number of
+// commits that changed it and the lines they added and deleted.
+// since limits the history to commits newer than a git date such as "1 year ago"; empty reads
+// the whole history.
+// Returns a map from absolute file paths to their FileChurn.
+func GetHistoricalFileChurn(repoPath, since string) (map[string]vcs.FileChurn, error) {
+	// Validate the repository path exists
+	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("repository path does not exist: %s", repoPath)
+	}
+
+	// Verify it's a git repository
+	if !isGitRepository(repoPath) {
+		return nil, fmt.Errorf("%s is not a git repository", repoPath)
+	}
+
+	repoRoot, err := GetRepositoryRoot(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository root: %w", err)
+	}
+
+	// Renames are not followed, so churn is attributed to the path each commit touched.
+	args := []string{"log", "--numstat", "--no-renames", "--format="}
+	if since != "" {
+		args = append(args, "--since="+since)
+	}
+	stdout, stderr, err := runGitCommand(repoPath, args...)
+	if err != nil {
+		return nil, gitCommandError(err, stderr)
+	}
+
+	churn := make(map[string]vcs.FileChurn)
+	for _, line := range strings.Split(string(stdout), "\n") {
+		// Format: additions	deletions	filename
+		parts := strings.SplitN(strings.TrimSpace(line), "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+
+		// Binary files report "-" for both counts and only contribute a commit.
+		additions, _ := strconv.Atoi(parts[0])
+		deletions, _ := strconv.Atoi(parts[1])
+
+		absPath := filepath.Join(repoRoot, filepath.Clean(parts[2]))
+		fileChurn := churn[absPath]
+		fileChurn.Commits++
+		fileChurn.Additions += additions
+		fileChurn.Deletions += deletions
+		churn[absPath] = fileChurn
+	}
+
+	return churn, nil
+}
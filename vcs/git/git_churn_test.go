@@ -0,0 +1,68 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHistoricalFileChurn_SumsCommitsAndLines(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "hot.txt", "one\ntwo\n")
+	createFile(t, tmpDir, "cold.txt", "one\n")
+	gitAdd(t, tmpDir, ".")
+	gitCommit(t, tmpDir, "Initial commit")
+
+	createFile(t, tmpDir, "hot.txt", "one\nthree\nfour\n")
+	gitAdd(t, tmpDir, "hot.txt")
+	gitCommit(t, tmpDir, "Change hot")
+
+	churn, err := GetHistoricalFileChurn(tmpDir, "")
+	require.NoError(t, err)
+
+	repoRoot, err := GetRepositoryRoot(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]vcs.FileChurn{
+		filepath.Join(repoRoot, "hot.txt"):  {Commits: 2, Additions: 4, Deletions: 1},
+		filepath.Join(repoRoot, "cold.txt"): {Commits: 1, Additions: 1, Deletions: 0},
+	}, churn)
+}
+
+func TestGetHistoricalFileChurn_SkipsCommitsBeforeSince(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "old.txt", "one\n")
+	gitAdd(t, tmpDir, ".")
+	cmd := exec.Command("git", "commit", "-m", "Old commit")
+	cmd.Dir = tmpDir
+	cmd.Env = append(os.Environ(), "GIT_COMMITTER_DATE=2001-01-01T00:00:00")
+	require.NoError(t, cmd.Run(), "failed to git commit")
+
+	createFile(t, tmpDir, "new.txt", "one\n")
+	gitAdd(t, tmpDir, ".")
+	gitCommit(t, tmpDir, "New commit")
+
+	churn, err := GetHistoricalFileChurn(tmpDir, "2010-01-01")
+	require.NoError(t, err)
+
+	repoRoot, err := GetRepositoryRoot(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]vcs.FileChurn{
+		filepath.Join(repoRoot, "new.txt"): {Commits: 1, Additions: 1, Deletions: 0},
+	}, churn)
+}
+
+func TestGetHistoricalFileChurn_NotGitRepo(t *testing.T) {
+	_, err := GetHistoricalFileChurn(t.TempDir(), "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a git repository")
+}
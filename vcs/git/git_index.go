@@ -0,0 +1,75 @@
+package git
+
+import (
+	"fmt"
+
+	"github.com/LegacyCodeHQ/clarity/vcs"
+)
+
+// GitIndexContentReader returns a ContentReader that reads staged file content from the git index.
+func GitIndexContentReader(repoPath string) vcs.ContentReader {
+	return func(absPath string) ([]byte, error) {
+		relPath := getRelativePath(absPath, repoPath)
+		return GetFileContentFromIndex(repoPath, relPath)
+	}
+}
+
+// GetFileContentFromIndex reads the staged content of a file using 'git show :path'.
+// The filePath should be relative to the repository root.
+func GetFileContentFromIndex(repoPath, filePath string) ([]byte, error) {
+	if err := validateGitRelPath(filePath); err != nil {
+		return nil, err
+	}
+
+	stdout, stderr, err := runGitCommand(repoPath, "show", ":"+filePath)
+	if err != nil {
+		if stderr != "" {
+			return nil, fmt.Errorf("git show failed: %s", stderr)
+		}
+		return nil, err
+	}
+
+	return stdout, nil
+}
+
+// GetStagedFiles returns absolute paths for files added, modified, or renamed in the index
+// relative to HEAD.
+func GetStagedFiles(repoPath string) ([]string, error) {
+	return getIndexDiffFiles(repoPath, "--cached")
+}
+
+// GetUnstagedFiles returns absolute paths for tracked files whose working tree content
+// differs from the index. Files deleted from the working tree are excluded.
+func GetUnstagedFiles(repoPath string) ([]string, error) {
+	return getIndexDiffFiles(repoPath)
+}
+
+// GetUnmergedFiles returns absolute paths for files with unresolved merge conflicts in the index.
+func GetUnmergedFiles(repoPath string) ([]string, error) {
+	repoRoot, err := ensureRepoRoot(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, stderr, err := runGitCommand(repoPath, "diff", "-z", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil, gitCommandError(err, stderr)
+	}
+
+	return toAbsolutePaths(repoRoot, parseNullSeparatedPaths(stdout)), nil
+}
+
+func getIndexDiffFiles(repoPath string, extraArgs ...string) ([]string, error) {
+	repoRoot, err := ensureRepoRoot(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append([]string{"diff", "-z", "--name-only", "--diff-filter=d"}, extraArgs...)
+	stdout, stderr, err := runGitCommand(repoPath, args...)
+	if err != nil {
+		return nil, gitCommandError(err, stderr)
+	}
+
+	return toAbsolutePaths(repoRoot, parseNullSeparatedPaths(stdout)), nil
+}
@@ -0,0 +1,94 @@
+package git
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitIndexContentReader_ReadsStagedContent(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	filePath := createFile(t, tmpDir, "file.txt", "committed\n")
+	gitAdd(t, tmpDir, "file.txt")
+	gitCommit(t, tmpDir, "Add file")
+	createFile(t, tmpDir, "file.txt", "staged\n")
+	gitAdd(t, tmpDir, "file.txt")
+	createFile(t, tmpDir, "file.txt", "unstaged\n")
+
+	content, err := GitIndexContentReader(tmpDir)(filePath)
+
+	require.NoError(t, err)
+	assert.Equal(t, "staged\n", string(content))
+}
+
+func TestGetFileContentFromIndex_UnstagedFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+	createFile(t, tmpDir, "new.txt", "content")
+
+	_, err := GetFileContentFromIndex(tmpDir, "new.txt")
+
+	require.Error(t, err)
+}
+
+func TestGetStagedAndUnstagedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "staged.txt", "one\n")
+	createFile(t, tmpDir, "unstaged.txt", "one\n")
+	createFile(t, tmpDir, "deleted.txt", "one\n")
+	gitAdd(t, tmpDir, ".")
+	gitCommit(t, tmpDir, "Initial commit")
+
+	createFile(t, tmpDir, "staged.txt", "two\n")
+	createFile(t, tmpDir, "added.txt", "new\n")
+	gitAdd(t, tmpDir, "staged.txt")
+	gitAdd(t, tmpDir, "added.txt")
+	createFile(t, tmpDir, "unstaged.txt", "two\n")
+	createFile(t, tmpDir, "untracked.txt", "new\n")
+	runGit(t, tmpDir, "rm", "-q", "deleted.txt")
+
+	staged, err := GetStagedFiles(tmpDir)
+	require.NoError(t, err)
+	unstaged, err := GetUnstagedFiles(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, "$REPO/added.txt\n$REPO/staged.txt", normalizeFilePaths(tmpDir, staged))
+	assert.Equal(t, "$REPO/unstaged.txt", normalizeFilePaths(tmpDir, unstaged))
+	for _, path := range append(staged, unstaged...) {
+		assert.True(t, filepath.IsAbs(path))
+	}
+}
+
+func TestGetUnmergedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "file.txt", "base\n")
+	gitAdd(t, tmpDir, "file.txt")
+	gitCommit(t, tmpDir, "Initial commit")
+	runGit(t, tmpDir, "branch", "-M", "main")
+	runGit(t, tmpDir, "checkout", "-q", "-b", "feature")
+	createFile(t, tmpDir, "file.txt", "feature\n")
+	gitAdd(t, tmpDir, "file.txt")
+	gitCommit(t, tmpDir, "Feature change")
+	runGit(t, tmpDir, "checkout", "-q", "main")
+	createFile(t, tmpDir, "file.txt", "main\n")
+	gitAdd(t, tmpDir, "file.txt")
+	gitCommit(t, tmpDir, "Main change")
+
+	cmd := exec.Command("git", "merge", "feature")
+	cmd.Dir = tmpDir
+	require.Error(t, cmd.Run(), "expected merge conflict")
+
+	unmerged, err := GetUnmergedFiles(tmpDir)
+
+	require.NoError(t, err)
+	assert.Equal(t, "$REPO/file.txt", normalizeFilePaths(tmpDir, unmerged))
+}
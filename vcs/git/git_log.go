@@ -0,0 +1,54 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CommitInfo describes a commit for display.
+type CommitInfo struct {
+	Hash    string
+	Author  string
+	Date    time.Time
+	Subject string
+}
+
+// GetCommitInfo returns the author, author date and subject of a commit.
+func GetCommitInfo(repoPath, commitID string) (CommitInfo, error) {
+	if err := validateCommit(repoPath, commitID); err != nil {
+		return CommitInfo{}, err
+	}
+
+	stdout, stderr, err := runGitCommand(repoPath, "log", "-1", "--format=%H%x00%an%x00%aI%x00%s", commitID)
+	if err != nil {
+		return CommitInfo{}, gitCommandError(err, stderr)
+	}
+
+	fields := strings.SplitN(strings.TrimSpace(string(stdout)), "\x00", 4)
+	if len(fields) != 4 {
+		return CommitInfo{}, fmt.Errorf("unexpected git log output for commit %s", commitID)
+	}
+
+	date, err := time.Parse(time.RFC3339, fields[2])
+	if err != nil {
+		return CommitInfo{}, fmt.Errorf("failed to parse date of commit %s: %w", commitID, err)
+	}
+
+	return CommitInfo{
+		Hash:    fields[0],
+		Author:  fields[1],
+		Date:    date,
+		Subject: fields[3],
+	}, nil
+}
+
+// GetCurrentBranch returns the checked out branch name, or "HEAD" when HEAD is detached.
+func GetCurrentBranch(repoPath string) (string, error) {
+	stdout, stderr, err := runGitCommand(repoPath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", gitCommandError(err, stderr)
+	}
+
+	return strings.TrimSpace(string(stdout)), nil
+}
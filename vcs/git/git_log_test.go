@@ -0,0 +1,50 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommitInfo_ReturnsAuthorDateAndSubject(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "file.txt", "content")
+	gitAdd(t, tmpDir, "file.txt")
+	sha := gitCommitAndGetSHA(t, tmpDir, "Add file\n\nWith a body")
+
+	info, err := GetCommitInfo(tmpDir, "HEAD")
+
+	require.NoError(t, err)
+	assert.Equal(t, sha, info.Hash)
+	assert.Equal(t, "Test User", info.Author)
+	assert.Equal(t, "Add file", info.Subject)
+	assert.False(t, info.Date.IsZero())
+}
+
+func TestGetCommitInfo_InvalidCommit(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	_, err := GetCommitInfo(tmpDir, "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid commit reference 'missing'")
+}
+
+func TestGetCurrentBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "file.txt", "content")
+	gitAdd(t, tmpDir, "file.txt")
+	gitCommit(t, tmpDir, "Add file")
+	runGit(t, tmpDir, "checkout", "-b", "feature/labels")
+
+	branch, err := GetCurrentBranch(tmpDir)
+
+	require.NoError(t, err)
+	assert.Equal(t, "feature/labels", branch)
+}
@@ -0,0 +1,61 @@
+package git
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// GetCommitRangeRenames returns the files git detects as renamed between two commits.
+// Returns a map from absolute old paths to absolute new paths.
+func GetCommitRangeRenames(repoPath, fromCommit, toCommit string) (map[string]string, error) {
+	if err := validateCommit(repoPath, fromCommit); err != nil {
+		return nil, err
+	}
+	if err := validateCommit(repoPath, toCommit); err != nil {
+		return nil, err
+	}
+	return getRenames(repoPath, fromCommit, toCommit)
+}
+
+// GetStagedRenames returns the files renamed in the index relative to HEAD.
+// Returns a map from absolute old paths to absolute new paths.
+func GetStagedRenames(repoPath string) (map[string]string, error) {
+	return getRenames(repoPath, "--cached")
+}
+
+// GetUncommittedRenames returns the files renamed between HEAD and the working tree. Git only
+// detects renames whose new path is in the index, e.g. after 'git mv' or 'git add'.
+// Returns a map from absolute old paths to absolute new paths.
+func GetUncommittedRenames(repoPath string) (map[string]string, error) {
+	return getRenames(repoPath, "HEAD")
+}
+
+func getRenames(repoPath string, diffArgs ...string) (map[string]string, error) {
+	repoRoot, err := ensureRepoRoot(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append([]string{"diff", "--numstat", "-M"}, diffArgs...)
+	stdout, stderr, err := runGitCommand(repoPath, args...)
+	if err != nil {
+		return nil, gitCommandError(err, stderr)
+	}
+
+	renames := make(map[string]string)
+	for _, line := range strings.Split(string(stdout), "\n") {
+		// Format: additions	deletions	filename
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+
+		oldPath, newPath, renamed := splitRenamedFilePath(parts[2])
+		if !renamed {
+			continue
+		}
+		renames[filepath.Join(repoRoot, filepath.Clean(oldPath))] = filepath.Join(repoRoot, filepath.Clean(newPath))
+	}
+
+	return renames, nil
+}
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitRenamedFilePath(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantOld     string
+		wantNew     string
+		wantRenamed bool
+	}{
+		{name: "full format", input: "old/file.go => new/file.go", wantOld: "old/file.go", wantNew: "new/file.go", wantRenamed: true},
+		{name: "abbreviated format", input: "src/{old => new}/file.go", wantOld: "src/old/file.go", wantNew: "src/new/file.go", wantRenamed: true},
+		{name: "abbreviated move into directory", input: "src/{ => lib}/file.go", wantOld: "src//file.go", wantNew: "src/lib/file.go", wantRenamed: true},
+		{name: "not a rename", input: "src/file.go", wantOld: "src/file.go", wantNew: "src/file.go", wantRenamed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPath, newPath, renamed := splitRenamedFilePath(tt.input)
+			assert.Equal(t, tt.wantOld, oldPath)
+			assert.Equal(t, tt.wantNew, newPath)
+			assert.Equal(t, tt.wantRenamed, renamed)
+		})
+	}
+}
+
+func TestGetCommitRangeRenames(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "moved.go", "package main\n\nfunc moved() {}\n")
+	createFile(t, tmpDir, "kept.go", "package main\n")
+	gitAdd(t, tmpDir, ".")
+	first := gitCommitAndGetSHA(t, tmpDir, "Initial commit")
+
+	createFile(t, tmpDir, "kept.go", "package main\n\nfunc kept() {}\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "lib"), 0o755))
+	runGit(t, tmpDir, "mv", "moved.go", "lib/moved.go")
+	gitAdd(t, tmpDir, ".")
+	second := gitCommitAndGetSHA(t, tmpDir, "Move file")
+
+	renames, err := GetCommitRangeRenames(tmpDir, first, second)
+
+	require.NoError(t, err)
+	resolvedDir, err := filepath.EvalSymlinks(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		filepath.Join(resolvedDir, "moved.go"): filepath.Join(resolvedDir, "lib", "moved.go"),
+	}, renames)
+}
+
+func TestGetStagedAndUncommittedRenames(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "old.go", "package main\n\nfunc moved() {}\n")
+	gitAdd(t, tmpDir, "old.go")
+	gitCommit(t, tmpDir, "Initial commit")
+	runGit(t, tmpDir, "mv", "old.go", "new.go")
+
+	resolvedDir, err := filepath.EvalSymlinks(tmpDir)
+	require.NoError(t, err)
+	want := map[string]string{filepath.Join(resolvedDir, "old.go"): filepath.Join(resolvedDir, "new.go")}
+
+	staged, err := GetStagedRenames(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, want, staged)
+
+	uncommitted, err := GetUncommittedRenames(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, want, uncommitted)
+}
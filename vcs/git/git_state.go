@@ -2,6 +2,8 @@ package git
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -35,3 +37,56 @@ func getHEADSignature(repoPath string) (string, error) {
 
 	return "", gitCommandError(err, stderr)
 }
+
+// IsUnbornHEAD reports whether HEAD points at a branch that has no commits yet.
+func IsUnbornHEAD(repoPath string) (bool, error) {
+	head, err := getHEADSignature(repoPath)
+	if err != nil {
+		return false, err
+	}
+	return head == unbornHeadSignature, nil
+}
+
+// IsShallowRepository reports whether the repository is a shallow clone.
+func IsShallowRepository(repoPath string) (bool, error) {
+	stdout, stderr, err := runGitCommand(repoPath, "rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return false, gitCommandError(err, stderr)
+	}
+	return strings.TrimSpace(string(stdout)) == "true", nil
+}
+
+// IsShallowBoundary reports whether a shallow clone cut off the parents of commitID, in which
+// case git reports the commit as having no parents.
+func IsShallowBoundary(repoPath, commitID string) (bool, error) {
+	if err := validateCommit(repoPath, commitID); err != nil {
+		return false, err
+	}
+
+	stdout, stderr, err := runGitCommand(repoPath, "rev-parse", "--git-path", "shallow")
+	if err != nil {
+		return false, gitCommandError(err, stderr)
+	}
+	shallowPath := strings.TrimSpace(string(stdout))
+	if !filepath.IsAbs(shallowPath) {
+		shallowPath = filepath.Join(repoPath, shallowPath)
+	}
+	content, err := os.ReadFile(shallowPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read shallow commits: %w", err)
+	}
+
+	hash, stderr, err := runGitCommand(repoPath, "rev-parse", "--verify", commitID+"^{commit}")
+	if err != nil {
+		return false, gitCommandError(err, stderr)
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) == strings.TrimSpace(string(hash)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
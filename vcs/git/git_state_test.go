@@ -1,6 +1,7 @@
 package git
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,3 +51,48 @@ func TestGetRepositoryStateSignature_ChangesOnStagingTransition(t *testing.T) {
 	assert.NotEqual(t, clean, dirtyUnstaged)
 	assert.NotEqual(t, dirtyUnstaged, dirtyStaged)
 }
+
+func TestIsUnbornHEAD(t *testing.T) {
+	dir := t.TempDir()
+	setupGitRepo(t, dir)
+
+	unborn, err := IsUnbornHEAD(dir)
+	require.NoError(t, err)
+	assert.True(t, unborn)
+
+	createFile(t, dir, "main.go", "package main\n")
+	gitAdd(t, dir, "main.go")
+	gitCommit(t, dir, "initial")
+
+	unborn, err = IsUnbornHEAD(dir)
+	require.NoError(t, err)
+	assert.False(t, unborn)
+}
+
+func TestIsShallowBoundary(t *testing.T) {
+	source := t.TempDir()
+	setupGitRepo(t, source)
+	createFile(t, source, "main.go", "package main\n")
+	gitAdd(t, source, "main.go")
+	gitCommit(t, source, "initial")
+	createFile(t, source, "main.go", "package main\n\nfunc main() {}\n")
+	gitAdd(t, source, "main.go")
+	gitCommit(t, source, "second")
+
+	clone := filepath.Join(t.TempDir(), "clone")
+	runGit(t, source, "clone", "-q", "--depth", "1", "file://"+source, clone)
+
+	shallow, err := IsShallowRepository(clone)
+	require.NoError(t, err)
+	assert.True(t, shallow)
+	boundary, err := IsShallowBoundary(clone, "HEAD")
+	require.NoError(t, err)
+	assert.True(t, boundary)
+
+	shallow, err = IsShallowRepository(source)
+	require.NoError(t, err)
+	assert.False(t, shallow)
+	boundary, err = IsShallowBoundary(source, "HEAD")
+	require.NoError(t, err)
+	assert.False(t, boundary)
+}
@@ -409,6 +409,15 @@ func getCommitRangeFileStatuses(repoPath, fromCommit, toCommit string) (map[stri
 // 1. Full format: "old_path => new_path" (returns new_path)
 // 2. Abbreviated format: "prefix/{old => new}/suffix" (returns prefix/new/suffix)
 func parseRenamedFilePath(filePath string) string {
+	_, newPath, _ := splitRenamedFilePath(filePath)
+	return newPath
+}
+
+// splitRenamedFilePath parses a renamed file path from git numstat output into its old (source)
+// and new (destination) paths. For paths that are not renames, renamed is false and both paths
+// are filePath. Paths may contain doubled separators, e.g. "prefix//suffix" for "prefix/{ => new}/suffix",
+// so callers should clean them.
+func splitRenamedFilePath(filePath string) (oldPath, newPath string, renamed bool) {
 	// Check for abbreviated rename format: "prefix/{ => new}/suffix" or "prefix/{old => new}/suffix"
 	if strings.Contains(filePath, "{") && strings.Contains(filePath, "}") {
 		// Find the positions of { and }
@@ -425,9 +434,9 @@ func parseRenamedFilePath(filePath string) string {
 			if strings.Contains(middle, " => ") {
 				parts := strings.Split(middle, " => ")
 				if len(parts) == 2 {
-					// Use the new (right) part
+					oldMiddle := strings.TrimSpace(parts[0])
 					newMiddle := strings.TrimSpace(parts[1])
-					return prefix + newMiddle + suffix
+					return prefix + oldMiddle + suffix, prefix + newMiddle + suffix, true
 				}
 			}
 		}
@@ -437,12 +446,12 @@ func parseRenamedFilePath(filePath string) string {
 	if strings.Contains(filePath, " => ") {
 		renameParts := strings.Split(filePath, " => ")
 		if len(renameParts) == 2 {
-			return strings.TrimSpace(renameParts[1])
+			return strings.TrimSpace(renameParts[0]), strings.TrimSpace(renameParts[1]), true
 		}
 	}
 
 	// Not a rename, return as-is
-	return filePath
+	return filePath, filePath, false
 }
 
 // isNewStatus determines if a git status code represents a new or untracked file
@@ -201,6 +201,48 @@ func TestGetShortCommitHash_InvalidCommit(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// Tests for ResolveCommit
+
+func TestResolveCommit_TagsStashesAndReflogEntries(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "test.txt", "first")
+	gitAdd(t, tmpDir, "test.txt")
+	firstCommit := gitCommitAndGetSHA(t, tmpDir, "First commit")
+	runGit(t, tmpDir, "tag", "v1")
+
+	createFile(t, tmpDir, "test.txt", "second")
+	gitAdd(t, tmpDir, "test.txt")
+	gitCommit(t, tmpDir, "Second commit")
+
+	previousHead, err := ResolveCommit(tmpDir, "HEAD@{1}")
+	require.NoError(t, err)
+	assert.Equal(t, firstCommit, previousHead)
+
+	createFile(t, tmpDir, "test.txt", "stashed")
+	runGit(t, tmpDir, "stash")
+	stashOutput, _, err := runGitCommand(tmpDir, "rev-parse", "stash@{0}")
+	require.NoError(t, err)
+	stashCommit := strings.TrimSpace(string(stashOutput))
+
+	for ref, want := range map[string]string{
+		"v1":        firstCommit,
+		"stash@{0}": stashCommit,
+	} {
+		got, err := ResolveCommit(tmpDir, ref)
+		require.NoError(t, err, ref)
+		assert.Equal(t, want, got, ref)
+	}
+}
+
+func TestValidateGitRef_AllowsSpacesOnlyInReflogSelectors(t *testing.T) {
+	assert.NoError(t, validateGitRef("HEAD@{2 days ago}"))
+	assert.NoError(t, validateGitRef("main@{1 week ago}"))
+	assert.Error(t, validateGitRef("main branch"))
+	assert.Error(t, validateGitRef("HEAD@{1}\t"))
+}
+
 // Tests for HasUncommittedChanges
 
 func TestHasUncommittedChanges_Clean(t *testing.T) {
@@ -518,3 +560,55 @@ func TestGetCommitRangeLabel_InvalidToCommit(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+func TestGetMergeBase_ReturnsForkPoint(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+
+	createFile(t, tmpDir, "base.txt", "content")
+	gitAdd(t, tmpDir, "base.txt")
+	forkCommit := gitCommitAndGetSHA(t, tmpDir, "Base commit")
+	runGit(t, tmpDir, "branch", "-M", "main")
+	runGit(t, tmpDir, "checkout", "-b", "feature")
+
+	createFile(t, tmpDir, "feature.txt", "content")
+	gitAdd(t, tmpDir, "feature.txt")
+	gitCommit(t, tmpDir, "Feature commit")
+
+	runGit(t, tmpDir, "checkout", "main")
+	createFile(t, tmpDir, "main.txt", "content")
+	gitAdd(t, tmpDir, "main.txt")
+	gitCommit(t, tmpDir, "Main commit")
+
+	mergeBase, err := GetMergeBase(tmpDir, "main", "feature")
+
+	require.NoError(t, err)
+	assert.Equal(t, forkCommit, mergeBase)
+}
+
+func TestGetMergeBase_InvalidBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+	createFile(t, tmpDir, "base.txt", "content")
+	gitAdd(t, tmpDir, "base.txt")
+	gitCommit(t, tmpDir, "Base commit")
+
+	_, err := GetMergeBase(tmpDir, "HEAD", "missing-branch")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid commit reference 'missing-branch'")
+}
+
+func TestGetDefaultBranch_FallsBackToLocalBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+	setupGitRepo(t, tmpDir)
+	createFile(t, tmpDir, "base.txt", "content")
+	gitAdd(t, tmpDir, "base.txt")
+	gitCommit(t, tmpDir, "Base commit")
+	runGit(t, tmpDir, "branch", "-M", "master")
+
+	branch, err := GetDefaultBranch(tmpDir)
+
+	require.NoError(t, err)
+	assert.Equal(t, "master", branch)
+}
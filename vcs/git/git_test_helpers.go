@@ -59,6 +59,14 @@ func gitCommit(t *testing.T, repoDir, message string) {
 	require.NoError(t, cmd.Run(), "failed to git commit")
 }
 
+// runGit runs a git command in the repository
+func runGit(t *testing.T, repoDir string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoDir
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %s failed: %s", strings.Join(args, " "), output)
+}
+
 // gitCommitAndGetSHA commits files and returns the commit SHA
 func gitCommitAndGetSHA(t *testing.T, repoDir, message string) string {
 	// Commit the files